@@ -0,0 +1,210 @@
+/*
+Package audit implements auditing of card conservation across a game session.
+
+An Auditor knows the universe of cards in the session and tracked places of cards,
+such as a deck, hands and piles. After each operation it checks that every card of the universe
+is in exactly one place, and reports cards which are lost, created or duplicated.
+
+Deck is a debug-mode wrapper of gocard.Deck which checks after each operation.
+Cards drawn from it are in flight until they appear in a tracked place,
+and Check reports cards still in flight as lost.
+*/
+package audit
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Violation is an error of card conservation found after operation Op.
+// Lost cards are in no places, Created cards are not in the universe,
+// and Duplicated cards are in places more than in the universe.
+type Violation struct {
+	Op         string
+	Lost       gocard.Cards
+	Created    gocard.Cards
+	Duplicated gocard.Cards
+}
+
+// Error returns message of the violation. (e.g. cards are not conserved after draw, lost [Ace of Spades])
+func (violation *Violation) Error() (msg string) {
+	var details []string
+	if len(violation.Lost) > 0 {
+		details = append(details, fmt.Sprintf("lost %v", violation.Lost))
+	}
+	if len(violation.Created) > 0 {
+		details = append(details, fmt.Sprintf("created %v", violation.Created))
+	}
+	if len(violation.Duplicated) > 0 {
+		details = append(details, fmt.Sprintf("duplicated %v", violation.Duplicated))
+	}
+	return fmt.Sprintf("cards are not conserved after %s, %s", violation.Op, strings.Join(details, ", "))
+}
+
+// place is a tracked place of cards.
+type place struct {
+	name  string
+	cards func() gocard.Cards
+}
+
+// Auditor is an auditor of card conservation.
+// Report is called with each violation if it is not nil. (e.g. panic or t.Error in tests)
+// Violations is all violations found.
+type Auditor struct {
+	Report     func(violation *Violation)
+	Violations []*Violation
+	universe   map[gocard.Card]int
+	places     []place
+	flight     gocard.Cards
+}
+
+// NewAuditor returns auditor of universe which is all cards in the session.
+func NewAuditor(universe gocard.Cards) (auditor *Auditor) {
+	auditor = &Auditor{universe: map[gocard.Card]int{}}
+	for _, card := range universe {
+		auditor.universe[card]++
+	}
+	return auditor
+}
+
+// Track tracks cards of place name. cards is called on each check and returns current cards in the place.
+func (auditor *Auditor) Track(name string, cards func() gocard.Cards) {
+	auditor.places = append(auditor.places, place{name: name, cards: cards})
+}
+
+// TrackCards tracks cards pointed by cards as place name. (e.g. hand of a player)
+func (auditor *Auditor) TrackCards(name string, cards *gocard.Cards) {
+	auditor.Track(name, func() gocard.Cards { return *cards })
+}
+
+// InFlight returns cards which are drawn from wrapped decks but are not in any tracked places yet.
+func (auditor *Auditor) InFlight() (cards gocard.Cards) {
+	return append(gocard.Cards{}, auditor.flight...)
+}
+
+// Locate returns names of places which have card.
+func (auditor *Auditor) Locate(card gocard.Card) (names []string) {
+	for _, place := range auditor.places {
+		if gocard.Cards(place.cards()).Contains(card) {
+			names = append(names, place.name)
+		}
+	}
+	return names
+}
+
+// Check checks card conservation after operation op, and returns violation as error.
+// Cards in flight must be in tracked places, otherwise they are lost.
+func (auditor *Auditor) Check(op string) (err error) {
+	return auditor.check(op, true)
+}
+
+// check checks card conservation after operation op.
+// If strict is false, cards in flight count as held by the player who drew them.
+func (auditor *Auditor) check(op string, strict bool) (err error) {
+	count := map[gocard.Card]int{}
+	for _, place := range auditor.places {
+		for _, card := range place.cards() {
+			count[card]++
+		}
+	}
+	// Each card is in flight as many times as its copies in the universe are missing from places.
+	var flight gocard.Cards
+	for _, card := range auditor.flight {
+		if count[card] < auditor.universe[card] && !strict {
+			flight = append(flight, card)
+			count[card]++
+		}
+	}
+	auditor.flight = flight
+
+	// Cards of a standard deck are compared in order of new deck, then others. (e.g. Joker)
+	violation := &Violation{Op: op}
+	compared := map[gocard.Card]bool{}
+	compare := func(card gocard.Card) {
+		if !compared[card] {
+			compared[card] = true
+			auditor.compare(violation, card, count[card])
+		}
+	}
+	for _, card := range gocard.NewDeck() {
+		compare(card)
+	}
+	for card := range count {
+		compare(card)
+	}
+	for card := range auditor.universe {
+		compare(card)
+	}
+	if len(violation.Lost) == 0 && len(violation.Created) == 0 && len(violation.Duplicated) == 0 {
+		return err
+	}
+
+	auditor.Violations = append(auditor.Violations, violation)
+	if auditor.Report != nil {
+		auditor.Report(violation)
+	}
+	return violation
+}
+
+// compare adds card to violation if n copies of card are not same as the universe.
+func (auditor *Auditor) compare(violation *Violation, card gocard.Card, n int) {
+	expected := auditor.universe[card]
+	switch {
+	case expected == 0 && n > 0:
+		violation.Created = append(violation.Created, card)
+	case n > expected:
+		violation.Duplicated = append(violation.Duplicated, card)
+	case n < expected:
+		violation.Lost = append(violation.Lost, card)
+	}
+}
+
+// Deck is a deck which is checked by Auditor after each operation. It implements gocard.Decker.
+type Deck struct {
+	Deck    gocard.Deck
+	auditor *Auditor
+}
+
+// Wrap returns deck of cards which is tracked as place name and checked by auditor.
+func (auditor *Auditor) Wrap(name string, deck gocard.Deck) (wrapped *Deck) {
+	wrapped = &Deck{Deck: deck, auditor: auditor}
+	auditor.Track(name, func() gocard.Cards { return gocard.Cards(wrapped.Deck) })
+	return wrapped
+}
+
+// Shuffle shuffles the deck.
+func (deck *Deck) Shuffle() {
+	deck.Deck.Shuffle()
+	deck.auditor.check("shuffle", false)
+}
+
+// ShuffleWith shuffles the deck with random source r.
+func (deck *Deck) ShuffleWith(r *rand.Rand) {
+	deck.Deck.ShuffleWith(r)
+	deck.auditor.check("shuffle", false)
+}
+
+// Draw draws card from the top of the deck. The card is in flight until it is in a tracked place.
+func (deck *Deck) Draw() (card gocard.Card, err error) {
+	if card, err = deck.Deck.Draw(); err != nil {
+		return card, err
+	}
+	deck.auditor.flight = append(deck.auditor.flight, card)
+	deck.auditor.check(fmt.Sprintf("draw %s", card), false)
+	return card, err
+}
+
+// PutTop puts a card on the top of the deck.
+func (deck *Deck) PutTop(card gocard.Card) {
+	deck.Deck.PutTop(card)
+	deck.auditor.check(fmt.Sprintf("put %s on top", card), false)
+}
+
+// PutBottom puts a card on the bottom of the deck.
+func (deck *Deck) PutBottom(card gocard.Card) {
+	deck.Deck.PutBottom(card)
+	deck.auditor.check(fmt.Sprintf("put %s on bottom", card), false)
+}
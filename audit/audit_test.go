@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Deck must be usable in place of gocard.Deck.
+var _ gocard.Decker = (*Deck)(nil)
+
+// Setup for test
+func setupAuditor() (auditor *Auditor, deck *Deck, hand *gocard.Cards) {
+	auditor = NewAuditor(gocard.Cards(gocard.NewDeck()))
+	deck = auditor.Wrap("deck", gocard.NewDeck())
+	hand = &gocard.Cards{}
+	auditor.TrackCards("hand", hand)
+	return auditor, deck, hand
+}
+
+// #################################
+// Test Auditor.Check()
+// #################################
+
+func TestCheckConserved(t *testing.T) {
+	auditor, deck, hand := setupAuditor()
+	deck.Shuffle()
+	for i := 0; i < 5; i++ {
+		card, _ := deck.Draw()
+		*hand = append(*hand, card)
+	}
+	card := (*hand)[0]
+	*hand = (*hand)[1:]
+	deck.PutBottom(card)
+
+	if err := auditor.Check("end"); err != nil || len(auditor.Violations) != 0 || len(auditor.InFlight()) != 0 {
+		expected := error(nil)
+		actual := auditor.Violations
+		msg := "Expected cards are conserved, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestCheckInFlight(t *testing.T) {
+	auditor, deck, _ := setupAuditor()
+	card, _ := deck.Draw()
+
+	if flight := auditor.InFlight(); len(flight) != 1 || flight[0] != card || len(auditor.Violations) != 0 {
+		expected := gocard.Cards{card}
+		actual := flight
+		msg := "Expected drawn card is in flight, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestCheckDuplicated(t *testing.T) {
+	auditor, deck, hand := setupAuditor()
+	reported := 0
+	auditor.Report = func(violation *Violation) { reported++ }
+
+	card, _ := deck.Draw()
+	*hand = append(*hand, card)
+	deck.PutTop(card)
+
+	var violation *Violation
+	if err := auditor.Check("end"); !errors.As(err, &violation) || len(violation.Duplicated) != 1 || reported != 2 {
+		expected := "Duplicated " + card.String()
+		actual := err
+		msg := "Couldn't catch violation as putting card in hand back to deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if places := auditor.Locate(card); len(places) != 2 {
+		expected := []string{"deck", "hand"}
+		actual := places
+		msg := "Duplicated card is not located in both places"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestCheckLost(t *testing.T) {
+	auditor, deck, _ := setupAuditor()
+	card, _ := deck.Draw()
+
+	var violation *Violation
+	if err := auditor.Check("end"); !errors.As(err, &violation) || len(violation.Lost) != 1 || violation.Lost[0] != card {
+		expected := "Lost " + card.String()
+		actual := err
+		msg := "Couldn't catch violation as drawn card is not in any places"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestCheckCreated(t *testing.T) {
+	auditor, _, hand := setupAuditor()
+	*hand = append(*hand, gocard.Card{})
+
+	var violation *Violation
+	if err := auditor.Check("end"); !errors.As(err, &violation) || len(violation.Created) != 1 {
+		expected := "Created " + gocard.Card{}.String()
+		actual := err
+		msg := "Couldn't catch violation as card not in universe is in hand"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestCheckShoe(t *testing.T) {
+	shoe := append(gocard.NewDeck(), gocard.NewDeck()...)
+	auditor := NewAuditor(gocard.Cards(shoe))
+	deck := auditor.Wrap("shoe", shoe)
+	var hand, discards gocard.Cards
+	auditor.TrackCards("hand", &hand)
+	auditor.TrackCards("discards", &discards)
+
+	for i := 0; i < 4; i++ {
+		card, _ := deck.Draw()
+		hand = append(hand, card)
+		if err := auditor.Check("draw"); err != nil {
+			t.Fatalf("Expected cards of shoe are conserved after draw, but not\nError: %v", err)
+		}
+		discards, hand = append(discards, hand[0]), hand[1:]
+		if err := auditor.Check("discard"); err != nil {
+			t.Fatalf("Expected cards of shoe are conserved after discard, but not\nError: %v", err)
+		}
+	}
+	if len(auditor.Violations) != 0 {
+		expected := 0
+		actual := auditor.Violations
+		msg := "Expected no violations of two-deck shoe, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+
+	// Both copies of drawn card are counted, so losing one is reported.
+	card, _ := deck.Draw()
+	var violation *Violation
+	if err := auditor.Check("lose"); !errors.As(err, &violation) || len(violation.Lost) != 1 || violation.Lost[0] != card {
+		expected := "Lost " + card.String()
+		actual := err
+		msg := "Couldn't catch violation as losing a copy of card in shoe"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
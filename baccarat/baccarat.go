@@ -0,0 +1,153 @@
+/*
+Package baccarat implements Punto Banco, the casino Baccarat.
+*/
+package baccarat
+
+import (
+	gocard "github.com/x-color/gocard"
+)
+
+// Bet is a bet of Baccarat. (PLAYER, BANKER, TIE, PLAYERPAIR, BANKERPAIR)
+// PLAYER, BANKER and TIE are also results of coups.
+type Bet int
+
+// These constant values are bets.
+const (
+	PLAYER Bet = iota + 1
+	BANKER
+	TIE
+	PLAYERPAIR
+	BANKERPAIR
+)
+
+// String returns string of bet. (e.g. Player)
+func (bet Bet) String() (msg string) {
+	switch bet {
+	case PLAYER:
+		return "Player"
+	case BANKER:
+		return "Banker"
+	case TIE:
+		return "Tie"
+	case PLAYERPAIR:
+		return "Player Pair"
+	case BANKERPAIR:
+		return "Banker Pair"
+	default:
+		return "Unknown"
+	}
+}
+
+// NewShoe returns shoe of decks decks. It is not shuffled.
+func NewShoe(decks int) (shoe gocard.Deck) {
+	for i := 0; i < decks; i++ {
+		shoe = append(shoe, gocard.NewDeck()...)
+	}
+	return shoe
+}
+
+// Value returns point value of card. (Ace: 1, Two ~ Nine: 2 ~ 9, Ten ~ King: 0)
+func Value(card gocard.Card) (value int) {
+	if card.Rank >= gocard.TEN {
+		return 0
+	}
+	return int(card.Rank)
+}
+
+// Points returns points of cards. It is the last digit of total value of cards.
+func Points(cards gocard.Cards) (points int) {
+	for _, card := range cards {
+		points += Value(card)
+	}
+	return points % 10
+}
+
+// Natural returns whether two cards are 8 or 9 points.
+func Natural(cards gocard.Cards) (natural bool) {
+	return len(cards) == 2 && Points(cards) >= 8
+}
+
+// PlayerDraws returns whether the player draws the third card with points of two cards.
+func PlayerDraws(points int) (draw bool) {
+	return points <= 5
+}
+
+// BankerDraws returns whether the banker draws the third card with points of two cards.
+// third is the third card of the player, and it is nil if the player stood.
+func BankerDraws(points int, third *gocard.Card) (draw bool) {
+	if third == nil {
+		return points <= 5
+	}
+	value := Value(*third)
+	switch points {
+	case 0, 1, 2:
+		return true
+	case 3:
+		return value != 8
+	case 4:
+		return value >= 2 && value <= 7
+	case 5:
+		return value >= 4 && value <= 7
+	case 6:
+		return value == 6 || value == 7
+	default:
+		return false
+	}
+}
+
+// Coup is a coup of Baccarat.
+type Coup struct {
+	Player gocard.Cards
+	Banker gocard.Cards
+}
+
+// Deal deals a coup from shoe with third-card rules, and returns error if shoe runs out.
+func Deal(shoe *gocard.Deck) (coup Coup, err error) {
+	draw := func(hand *gocard.Cards) {
+		if err != nil {
+			return
+		}
+		var card gocard.Card
+		if card, err = shoe.Draw(); err == nil {
+			*hand = append(*hand, card)
+		}
+	}
+	draw(&coup.Player)
+	draw(&coup.Banker)
+	draw(&coup.Player)
+	draw(&coup.Banker)
+	if err != nil || Natural(coup.Player) || Natural(coup.Banker) {
+		return coup, err
+	}
+
+	var third *gocard.Card
+	if PlayerDraws(Points(coup.Player)) {
+		draw(&coup.Player)
+		if err != nil {
+			return coup, err
+		}
+		third = &coup.Player[2]
+	}
+	if BankerDraws(Points(coup.Banker), third) {
+		draw(&coup.Banker)
+	}
+	return coup, err
+}
+
+// Winner returns result of coup. (PLAYER, BANKER or TIE)
+func (coup Coup) Winner() (winner Bet) {
+	player, banker := Points(coup.Player), Points(coup.Banker)
+	switch {
+	case player > banker:
+		return PLAYER
+	case banker > player:
+		return BANKER
+	default:
+		return TIE
+	}
+}
+
+// Pair returns whether the first two cards of hand are same rank.
+func Pair(hand gocard.Cards) (pair bool) {
+	return len(hand) >= 2 && hand[0].Rank == hand[1].Rank
+}
@@ -0,0 +1,103 @@
+package baccarat
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupShoe(ranks ...gocard.Rank) (shoe gocard.Deck) {
+	for _, rank := range ranks {
+		shoe = append(shoe, gocard.Card{Rank: rank, Suit: gocard.SPADES})
+	}
+	return shoe
+}
+
+// #################################
+// Test Points()
+// #################################
+
+func TestPoints(t *testing.T) {
+	cards := gocard.Cards{
+		{Rank: gocard.SEVEN, Suit: gocard.SPADES},
+		{Rank: gocard.KING, Suit: gocard.HEARTS},
+		{Rank: gocard.EIGHT, Suit: gocard.CLUBS},
+	}
+
+	if points := Points(cards); points != 5 {
+		expected := 5
+		actual := points
+		msg := "Points of cards are not the last digit of total"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test BankerDraws()
+// #################################
+
+func TestBankerDraws(t *testing.T) {
+	tests := []struct {
+		points int
+		third  *gocard.Card
+		draw   bool
+	}{
+		{5, nil, true},
+		{6, nil, false},
+		{3, &gocard.Card{Rank: gocard.EIGHT}, false},
+		{3, &gocard.Card{Rank: gocard.NINE}, true},
+		{4, &gocard.Card{Rank: gocard.ACE}, false},
+		{5, &gocard.Card{Rank: gocard.FOUR}, true},
+		{6, &gocard.Card{Rank: gocard.SIX}, true},
+		{6, &gocard.Card{Rank: gocard.FIVE}, false},
+		{7, &gocard.Card{Rank: gocard.SEVEN}, false},
+	}
+	for _, test := range tests {
+		if draw := BankerDraws(test.points, test.third); draw != test.draw {
+			expected := test.draw
+			actual := draw
+			msg := "Banker's drawing is not same as third-card rule"
+			t.Fatalf("%s (%d points, %v)\nExpected: %v\nActual  : %v", msg, test.points, test.third, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test Deal()
+// #################################
+
+func TestDealNatural(t *testing.T) {
+	shoe := setupShoe(gocard.FOUR, gocard.TWO, gocard.FIVE, gocard.ACE, gocard.TEN)
+
+	coup, err := Deal(&shoe)
+	if err != nil || len(coup.Player) != 2 || len(coup.Banker) != 2 || coup.Winner() != PLAYER {
+		expected := PLAYER
+		actual := coup
+		msg := "Expected no one draws after natural of the player, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestDealThirdCards(t *testing.T) {
+	// Player: Two, Three => draws Five (0), Banker: King, Five => draws Four with player's Five (9)
+	shoe := setupShoe(gocard.TWO, gocard.KING, gocard.THREE, gocard.FIVE, gocard.FIVE, gocard.FOUR)
+
+	coup, err := Deal(&shoe)
+	if err != nil || len(coup.Player) != 3 || len(coup.Banker) != 3 || coup.Winner() != BANKER {
+		expected := "3 cards each, Banker wins 9 to 0"
+		actual := coup
+		msg := "Expected both player and banker draw the third card, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestDealEmptyShoe(t *testing.T) {
+	shoe := setupShoe(gocard.TWO, gocard.TWO, gocard.TWO)
+	if _, err := Deal(&shoe); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as dealing from short shoe"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
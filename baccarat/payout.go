@@ -0,0 +1,43 @@
+package baccarat
+
+// Payout is payout odds of bets.
+// Commission is commission rate for winning banker bets. (e.g. 0.05)
+type Payout struct {
+	Commission float64
+	Tie        float64
+	Pair       float64
+}
+
+// DefaultPayout is common payout. (5% commission, Tie 8 to 1, Pair 11 to 1)
+var DefaultPayout = Payout{Commission: 0.05, Tie: 8, Pair: 11}
+
+// Settle returns net win of amount bet on bet in coup. It is negative if the bet loses.
+// Player and banker bets are pushed (0) on tie.
+func (payout Payout) Settle(coup Coup, bet Bet, amount float64) (net float64) {
+	winner := coup.Winner()
+	switch bet {
+	case PLAYER, BANKER:
+		switch winner {
+		case TIE:
+			return 0
+		case bet:
+			if bet == BANKER {
+				return amount * (1 - payout.Commission)
+			}
+			return amount
+		}
+	case TIE:
+		if winner == TIE {
+			return amount * payout.Tie
+		}
+	case PLAYERPAIR:
+		if Pair(coup.Player) {
+			return amount * payout.Pair
+		}
+	case BANKERPAIR:
+		if Pair(coup.Banker) {
+			return amount * payout.Pair
+		}
+	}
+	return -amount
+}
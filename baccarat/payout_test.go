@@ -0,0 +1,43 @@
+package baccarat
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test Payout.Settle()
+// #################################
+
+func TestSettle(t *testing.T) {
+	banker := Coup{
+		Player: gocard.Cards{{Rank: gocard.TWO, Suit: gocard.SPADES}, {Rank: gocard.TWO, Suit: gocard.HEARTS}},
+		Banker: gocard.Cards{{Rank: gocard.SIX, Suit: gocard.SPADES}, {Rank: gocard.ACE, Suit: gocard.HEARTS}},
+	}
+	tie := Coup{
+		Player: gocard.Cards{{Rank: gocard.SIX, Suit: gocard.CLUBS}, {Rank: gocard.KING, Suit: gocard.HEARTS}},
+		Banker: gocard.Cards{{Rank: gocard.SIX, Suit: gocard.SPADES}, {Rank: gocard.QUEEN, Suit: gocard.HEARTS}},
+	}
+	tests := []struct {
+		coup Coup
+		bet  Bet
+		net  float64
+	}{
+		{banker, BANKER, 95},
+		{banker, PLAYER, -100},
+		{banker, TIE, -100},
+		{banker, PLAYERPAIR, 1100},
+		{banker, BANKERPAIR, -100},
+		{tie, PLAYER, 0},
+		{tie, TIE, 800},
+	}
+	for _, test := range tests {
+		if net := DefaultPayout.Settle(test.coup, test.bet, 100); net != test.net {
+			expected := test.net
+			actual := net
+			msg := "Net win of bet is not expected"
+			t.Fatalf("%s (%s)\nExpected: %v\nActual  : %v", msg, test.bet, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CallType is type of call in the auction. (PASS, BID, DOUBLE, REDOUBLE)
+type CallType int
+
+// These constant values are types of call.
+const (
+	PASS CallType = iota
+	BID
+	DOUBLE
+	REDOUBLE
+)
+
+// Bid is a bid of level (1 ~ 7) and strain.
+type Bid struct {
+	Level  int
+	Strain Strain
+}
+
+// String returns string of bid. (e.g. 3NT)
+func (bid Bid) String() (msg string) {
+	return fmt.Sprintf("%d%s", bid.Level, bid.Strain)
+}
+
+// higher returns whether bid is higher than other.
+func (bid Bid) higher(other Bid) (higher bool) {
+	return bid.Level*5+int(bid.Strain) > other.Level*5+int(other.Strain)
+}
+
+// Call is a call in the auction. Bid is used only if Type is BID.
+type Call struct {
+	Type CallType
+	Bid  Bid
+}
+
+// String returns string of call. (e.g. Pass, 1S, X, XX)
+func (call Call) String() (msg string) {
+	switch call.Type {
+	case PASS:
+		return "Pass"
+	case BID:
+		return call.Bid.String()
+	case DOUBLE:
+		return "X"
+	case REDOUBLE:
+		return "XX"
+	default:
+		return "Unknown"
+	}
+}
+
+// These values are calls except bids.
+var (
+	Pass     = Call{Type: PASS}
+	Double   = Call{Type: DOUBLE}
+	Redouble = Call{Type: REDOUBLE}
+)
+
+// NewBid returns call of bid.
+func NewBid(level int, strain Strain) (call Call) {
+	return Call{Type: BID, Bid: Bid{Level: level, Strain: strain}}
+}
+
+// Risk is doubled state of contract. (UNDOUBLED, DOUBLED, REDOUBLED)
+type Risk int
+
+// These constant values are risks of contract.
+const (
+	UNDOUBLED Risk = iota
+	DOUBLED
+	REDOUBLED
+)
+
+// Contract is a final contract of the auction. Level 0 means the deal is passed out.
+type Contract struct {
+	Level    int
+	Strain   Strain
+	Risk     Risk
+	Declarer Seat
+}
+
+// String returns string of contract. (e.g. 4HX by South)
+func (contract Contract) String() (msg string) {
+	if contract.PassedOut() {
+		return "Passed out"
+	}
+	msg = fmt.Sprintf("%d%s", contract.Level, contract.Strain)
+	switch contract.Risk {
+	case DOUBLED:
+		msg += "X"
+	case REDOUBLED:
+		msg += "XX"
+	}
+	return fmt.Sprintf("%s by %s", msg, contract.Declarer)
+}
+
+// PassedOut returns whether the deal is passed out.
+func (contract Contract) PassedOut() (passedOut bool) {
+	return contract.Level == 0
+}
+
+// Auction is an auction which starts from Dealer.
+type Auction struct {
+	Dealer Seat
+	Calls  []Call
+}
+
+// NewAuction returns new auction which dealer is dealer.
+func NewAuction(dealer Seat) (auction *Auction) {
+	return &Auction{Dealer: dealer}
+}
+
+// Turn returns seat of the player to call next.
+func (auction *Auction) Turn() (seat Seat) {
+	return (auction.Dealer + Seat(len(auction.Calls))) % 4
+}
+
+// seatOf returns seat of the player who made i-th call.
+func (auction *Auction) seatOf(i int) (seat Seat) {
+	return (auction.Dealer + Seat(i)) % 4
+}
+
+// lastAction returns index of the last call which is not pass. It returns -1 if all calls are pass.
+func (auction *Auction) lastAction() (index int) {
+	for i := len(auction.Calls) - 1; i >= 0; i-- {
+		if auction.Calls[i].Type != PASS {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastBid returns index of the last bid. It returns -1 if nobody bids.
+func (auction *Auction) lastBid() (index int) {
+	for i := len(auction.Calls) - 1; i >= 0; i-- {
+		if auction.Calls[i].Type == BID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Done returns whether the auction is finished.
+// It is finished by four passes at the start or three passes after a bid.
+func (auction *Auction) Done() (done bool) {
+	n := len(auction.Calls)
+	if n < 4 {
+		return false
+	}
+	return auction.lastAction() < n-3
+}
+
+// Legal returns error if the next player couldn't make call.
+func (auction *Auction) Legal(call Call) (err error) {
+	if auction.Done() {
+		return errors.New("couldn't call, auction is finished")
+	}
+	last := auction.lastAction()
+	opponent := last >= 0 && auction.seatOf(last).Side() != auction.Turn().Side()
+	switch call.Type {
+	case PASS:
+		return err
+	case BID:
+		if call.Bid.Level < 1 || call.Bid.Level > 7 || call.Bid.Strain < CLUBS || call.Bid.Strain > NOTRUMP {
+			return fmt.Errorf("couldn't bid %s, it is not valid bid", call.Bid)
+		}
+		if i := auction.lastBid(); i >= 0 && !call.Bid.higher(auction.Calls[i].Bid) {
+			return fmt.Errorf("couldn't bid %s, it is not higher than %s", call.Bid, auction.Calls[i].Bid)
+		}
+		return err
+	case DOUBLE:
+		if !opponent || auction.Calls[last].Type != BID {
+			return errors.New("couldn't double, only bid of opponents can be doubled")
+		}
+		return err
+	case REDOUBLE:
+		if !opponent || auction.Calls[last].Type != DOUBLE {
+			return errors.New("couldn't redouble, only double of opponents can be redoubled")
+		}
+		return err
+	default:
+		return errors.New("couldn't call, it is unknown call")
+	}
+}
+
+// Call makes call by the next player, and returns error of illegal call.
+func (auction *Auction) Call(call Call) (err error) {
+	if err = auction.Legal(call); err != nil {
+		return err
+	}
+	auction.Calls = append(auction.Calls, call)
+	return err
+}
+
+// Contract returns the final contract, and error if the auction is not finished.
+func (auction *Auction) Contract() (contract Contract, err error) {
+	if !auction.Done() {
+		err = errors.New("couldn't decide contract, auction is not finished")
+		return contract, err
+	}
+	last := auction.lastBid()
+	if last < 0 {
+		return contract, err
+	}
+	bid := auction.Calls[last].Bid
+	contract.Level = bid.Level
+	contract.Strain = bid.Strain
+	switch auction.Calls[auction.lastAction()].Type {
+	case DOUBLE:
+		contract.Risk = DOUBLED
+	case REDOUBLE:
+		contract.Risk = REDOUBLED
+	}
+	side := auction.seatOf(last).Side()
+	for i, call := range auction.Calls {
+		if call.Type == BID && call.Bid.Strain == bid.Strain && auction.seatOf(i).Side() == side {
+			contract.Declarer = auction.seatOf(i)
+			break
+		}
+	}
+	return contract, err
+}
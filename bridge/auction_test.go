@@ -0,0 +1,104 @@
+package bridge
+
+import (
+	"testing"
+)
+
+// #################################
+// Test Auction.Call()
+// #################################
+
+func TestCallInsufficientBid(t *testing.T) {
+	auction := NewAuction(NORTH)
+	auction.Call(NewBid(1, SPADES))
+
+	if err := auction.Call(NewBid(1, HEARTS)); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as bidding lower than last bid"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestCallDoubleOwnSide(t *testing.T) {
+	auction := NewAuction(NORTH)
+	auction.Call(NewBid(1, CLUBS))
+	auction.Call(Pass)
+
+	if err := auction.Call(Double); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as doubling bid of partner"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestCallRedoubleWithoutDouble(t *testing.T) {
+	auction := NewAuction(NORTH)
+	auction.Call(NewBid(1, CLUBS))
+
+	if err := auction.Call(Redouble); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as redoubling without double"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Auction.Contract()
+// #################################
+
+func TestContract(t *testing.T) {
+	auction := NewAuction(EAST)
+	calls := []Call{
+		Pass, NewBid(1, HEARTS), Pass, NewBid(2, HEARTS),
+		Double, Redouble, Pass, Pass, Pass,
+	}
+	for _, call := range calls {
+		if err := auction.Call(call); err != nil {
+			t.Fatalf("Couldn't make call %s\nError: %v", call, err)
+		}
+	}
+
+	contract, err := auction.Contract()
+	expected := Contract{Level: 2, Strain: HEARTS, Risk: REDOUBLED, Declarer: SOUTH}
+	if err != nil || contract != expected {
+		actual := contract
+		msg := "Contract is not expected contract"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestContractPassedOut(t *testing.T) {
+	auction := NewAuction(WEST)
+	for i := 0; i < 4; i++ {
+		auction.Call(Pass)
+	}
+
+	if contract, err := auction.Contract(); err != nil || !contract.PassedOut() {
+		expected := "Passed out"
+		actual := contract
+		msg := "Expected the deal is passed out, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := auction.Call(NewBid(1, CLUBS)); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as calling after auction is finished"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestContractNotFinished(t *testing.T) {
+	auction := NewAuction(NORTH)
+	auction.Call(NewBid(1, NOTRUMP))
+	auction.Call(Pass)
+
+	if _, err := auction.Contract(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as deciding contract of unfinished auction"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Package bridge implements Contract Bridge: auction, declarer play, scoring and hand evaluation.
+
+Seats are North, East, South and West in clockwise order. North-South and East-West are partners.
+*/
+package bridge
+
+import (
+	gocard "github.com/x-color/gocard"
+)
+
+// Seat is seat of player. (NORTH, EAST, SOUTH, WEST)
+type Seat int
+
+// These constant values are seats in clockwise order.
+const (
+	NORTH Seat = iota
+	EAST
+	SOUTH
+	WEST
+)
+
+// String returns string of seat. (e.g. North)
+func (seat Seat) String() (msg string) {
+	switch seat {
+	case NORTH:
+		return "North"
+	case EAST:
+		return "East"
+	case SOUTH:
+		return "South"
+	case WEST:
+		return "West"
+	default:
+		return "Unknown"
+	}
+}
+
+// Next returns seat on the left of seat.
+func (seat Seat) Next() (next Seat) {
+	return (seat + 1) % 4
+}
+
+// Partner returns seat of partner.
+func (seat Seat) Partner() (partner Seat) {
+	return (seat + 2) % 4
+}
+
+// Side returns side of seat.
+func (seat Seat) Side() (side Side) {
+	return Side(seat % 2)
+}
+
+// Side is partnership. (NS, EW)
+type Side int
+
+// These constant values are sides.
+const (
+	NS Side = iota
+	EW
+)
+
+// String returns string of side. (e.g. North-South)
+func (side Side) String() (msg string) {
+	switch side {
+	case NS:
+		return "North-South"
+	case EW:
+		return "East-West"
+	default:
+		return "Unknown"
+	}
+}
+
+// Strain is denomination of bid. (CLUBS < DIAMONDS < HEARTS < SPADES < NOTRUMP)
+type Strain int
+
+// These constant values are strains in ascending order.
+const (
+	CLUBS Strain = iota
+	DIAMONDS
+	HEARTS
+	SPADES
+	NOTRUMP
+)
+
+// String returns string of strain. (e.g. NT)
+func (strain Strain) String() (msg string) {
+	switch strain {
+	case CLUBS:
+		return "C"
+	case DIAMONDS:
+		return "D"
+	case HEARTS:
+		return "H"
+	case SPADES:
+		return "S"
+	case NOTRUMP:
+		return "NT"
+	default:
+		return "Unknown"
+	}
+}
+
+// Suit returns trump suit of strain. It returns gocard.NOTRUMP for NOTRUMP.
+func (strain Strain) Suit() (suit gocard.Suit) {
+	switch strain {
+	case CLUBS:
+		return gocard.CLUBS
+	case DIAMONDS:
+		return gocard.DIAMONDS
+	case HEARTS:
+		return gocard.HEARTS
+	case SPADES:
+		return gocard.SPADES
+	default:
+		return gocard.NOTRUMP
+	}
+}
+
+// Vulnerability is vulnerability of a deal. (NONE, NSVUL, EWVUL, BOTH)
+type Vulnerability int
+
+// These constant values are vulnerabilities.
+const (
+	NONE Vulnerability = iota
+	NSVUL
+	EWVUL
+	BOTH
+)
+
+// Vulnerable returns whether side is vulnerable.
+func (vul Vulnerability) Vulnerable(side Side) (vulnerable bool) {
+	switch vul {
+	case BOTH:
+		return true
+	case NSVUL:
+		return side == NS
+	case EWVUL:
+		return side == EW
+	default:
+		return false
+	}
+}
+
+// suits is suits in the order used by bridge. (Spades, Hearts, Diamonds, Clubs)
+var suits = []gocard.Suit{gocard.SPADES, gocard.HEARTS, gocard.DIAMONDS, gocard.CLUBS}
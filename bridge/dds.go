@@ -0,0 +1,743 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"runtime"
+	"sync"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Cards are represented as bit sets in double dummy solver.
+// Suit i (Spades, Hearts, Diamonds, Clubs) uses bits 16*i ~ 16*i+12, and bit of rank is 0 (Two) ~ 12 (Ace).
+type cardSet uint64
+
+const suitBits = 0x1fff
+
+// toCardSet returns bit set of cards.
+func toCardSet(cards gocard.Cards) (set cardSet) {
+	for _, card := range cards {
+		set |= cardBit(card)
+	}
+	return set
+}
+
+// cardBit returns bit of card.
+func cardBit(card gocard.Card) (bit cardSet) {
+	r := (int(card.Rank) + 11) % 13
+	for i, suit := range suits {
+		if card.Suit == suit {
+			return 1 << uint(16*i+r)
+		}
+	}
+	return 0
+}
+
+// suitOf returns suit index of bit index.
+func suitOf(index int) (suit int) {
+	return index / 16
+}
+
+// suit returns cards of suit in set.
+func (set cardSet) suit(suit int) (cards cardSet) {
+	return set & (suitBits << uint(16*suit))
+}
+
+// highest returns index of the highest card in set. Set must not be empty.
+func (set cardSet) highest() (index int) {
+	return 63 - bits.LeadingZeros64(uint64(set))
+}
+
+// count returns number of cards in set.
+func (set cardSet) count() (n int) {
+	return bits.OnesCount64(uint64(set))
+}
+
+// ttKey is lengths of suits in hands and the leader at the start of a trick, in 4 bits each.
+// Length of Clubs of West is not in the key, it is known from the others. The leader is in its place.
+// Positions of a key are told apart by owners of their cards.
+type ttKey uint64
+
+// owners is owners of remaining cards in a position.
+// Each suit is encoded by owners of cards from low to high in 2 bits each, and two suits are in a word.
+type owners [2]uint64
+
+// ttEntry is bounds of tricks taken by North-South from positions whose owners of cards in mask are owners.
+// Mask keeps top cards of each suit whose ranks decide the bounds (winning ranks), so positions which differ
+// only in owners of lower cards share the entry. (partition search)
+// Lead is the best lead found, as suit and number of higher cards in the suit, or -1.
+type ttEntry struct {
+	owners owners
+	mask   owners
+	lower  int8
+	upper  int8
+	lead   int8
+}
+
+// maxEntries is max number of entries of a key in transposition table.
+const maxEntries = 256
+
+// match returns whether the position of owners is in the entry.
+func (entry *ttEntry) match(owners owners) (match bool) {
+	return owners[0]&entry.mask[0] == entry.owners[0] && owners[1]&entry.mask[1] == entry.owners[1]
+}
+
+// compressed is ranks of cards among remaining cards in 7 bits of a suit. (compressed[remaining][cards])
+// spread is 13 bits spread to even bits.
+var (
+	compressed [1 << 7][1 << 7]uint8
+	spread     [1 << 13]uint32
+)
+
+func init() {
+	for remaining := range compressed {
+		for cards := range compressed[remaining] {
+			n := uint(0)
+			for i := uint(0); i < 7; i++ {
+				if remaining&(1<<i) == 0 {
+					continue
+				}
+				if cards&(1<<i) != 0 {
+					compressed[remaining][cards] |= 1 << n
+				}
+				n++
+			}
+		}
+	}
+	for cards := range spread {
+		for i := uint(0); i < 13; i++ {
+			if cards&(1<<i) != 0 {
+				spread[cards] |= 1 << (2 * i)
+			}
+		}
+	}
+}
+
+// compress returns bits of cards of a suit which are ranked among remaining cards of the suit.
+// (e.g. Ace is bit 1 if remaining cards are Ace and Two)
+func compress(cards uint64, remaining uint64) (ranks uint64) {
+	low := uint64(compressed[remaining&0x7f][cards&0x7f])
+	high := uint64(compressed[remaining>>7][cards>>7])
+	return low | high<<uint(bits.OnesCount64(remaining&0x7f))
+}
+
+// solver is double dummy solver of a strain.
+// Its transposition table is kept between solves, so all leaders of the strain share positions.
+type solver struct {
+	hands [4]cardSet
+	trump int
+	tt    map[ttKey][]ttEntry
+}
+
+func newSolver(hands [4]cardSet, strain Strain) (s *solver) {
+	s = &solver{hands: hands, trump: -1, tt: map[ttKey][]ttEntry{}}
+	for i, suit := range suits {
+		if strain.Suit() == suit {
+			s.trump = i
+		}
+	}
+	return s
+}
+
+// remaining returns cards in all hands.
+func (s *solver) remaining() (cards cardSet) {
+	return s.hands[0] | s.hands[1] | s.hands[2] | s.hands[3]
+}
+
+// key returns key of transposition table and owners of cards for the position led by leader.
+func (s *solver) key(leader int) (key ttKey, owners owners) {
+	all := s.remaining()
+	for suit := 0; suit < 4; suit++ {
+		shift := uint(16 * suit)
+		remaining := uint64(all>>shift) & suitBits
+		k := uint64(0)
+		key |= ttKey(s.hands[0].suit(suit).count()) << uint(4*suit)
+		for seat := 1; seat < 4; seat++ {
+			cards := uint64(s.hands[seat]>>shift) & suitBits
+			key |= ttKey(bits.OnesCount64(cards)) << uint(16*seat+4*suit)
+			k |= uint64(spread[compress(cards, remaining)]) * uint64(seat)
+		}
+		owners[suit/2] |= k << uint(30*(suit%2))
+	}
+	key = key&^(0xf<<60) | ttKey(leader)<<60
+	return key, owners
+}
+
+// mask returns mask of owners which keeps cards of each suit from the top down to the lowest relevant card.
+func (s *solver) mask(relevant cardSet) (mask owners) {
+	all := s.remaining()
+	for suit := 0; suit < 4; suit++ {
+		cards := relevant.suit(suit)
+		if cards == 0 {
+			continue
+		}
+		lower := all.suit(suit) & (cards&-cards - 1)
+		m := (uint64(1)<<uint(2*all.suit(suit).count()) - 1) &^ (uint64(1)<<uint(2*lower.count()) - 1)
+		mask[suit/2] |= m << uint(30*(suit%2))
+	}
+	return mask
+}
+
+// relevant returns cards kept by mask of owners.
+func (s *solver) relevant(mask owners) (relevant cardSet) {
+	if mask == (owners{}) {
+		return relevant
+	}
+	all := s.remaining()
+	for suit := 0; suit < 4; suit++ {
+		m := mask[suit/2] >> uint(30*(suit%2)) & (1<<26 - 1)
+		if m == 0 {
+			continue
+		}
+		cards := all.suit(suit)
+		for lower := bits.TrailingZeros64(m) / 2; lower > 0; lower-- {
+			cards &= cards - 1
+		}
+		relevant |= cards
+	}
+	return relevant
+}
+
+// store stores entry of key. Bounds are merged if there is an entry of same positions.
+func (s *solver) store(key ttKey, entry ttEntry) {
+	entries := s.tt[key]
+	for i := range entries {
+		if entries[i].owners == entry.owners && entries[i].mask == entry.mask {
+			if entry.lower < entries[i].lower {
+				entry.lower = entries[i].lower
+			}
+			if entry.upper > entries[i].upper {
+				entry.upper = entries[i].upper
+			}
+			entries[i] = entry
+			return
+		}
+	}
+	// New entries are in front, and the oldest one is dropped from a full list.
+	if len(entries) < maxEntries {
+		entries = append(entries, entry)
+	}
+	copy(entries[1:], entries)
+	entries[0] = entry
+	s.tt[key] = entries
+}
+
+// encodeLead returns lead of transposition table for card index.
+func (s *solver) encodeLead(index int) (lead int8) {
+	higher := s.remaining().suit(suitOf(index)) &^ (cardSet(2)<<uint(index) - 1)
+	return int8(16*suitOf(index) + higher.count())
+}
+
+// decodeLead returns card index of lead of transposition table, or -1 if there is no lead.
+func (s *solver) decodeLead(lead int8) (index int) {
+	if lead < 0 {
+		return -1
+	}
+	cards := s.remaining().suit(int(lead) / 16)
+	for i := 0; i < int(lead)%16; i++ {
+		cards &^= 1 << uint(cards.highest())
+	}
+	return cards.highest()
+}
+
+// quickTricks returns number of tricks which leader's side can surely take by cashing top cards,
+// from leader's hand or from partner's hand reached by leading to its top card, and the cards cashed.
+func (s *solver) quickTricks(leader int) (tricks int, cards cardSet) {
+	tricks, cards = s.topTricks(leader)
+	partner := (leader + 2) % 4
+	for suit := 0; suit < 4; suit++ {
+		if s.ruffable(leader, suit) || s.hands[leader].suit(suit) == 0 || s.hands[partner].suit(suit) == 0 {
+			continue
+		}
+		if s.hands[partner]&(1<<uint(s.remaining().suit(suit).highest())) != 0 {
+			if t, c := s.topTricks(partner); t > tricks {
+				tricks, cards = t, c
+			}
+			break
+		}
+	}
+	return tricks, cards
+}
+
+// ruffable returns whether an opponent of seat could ruff when suit is led.
+func (s *solver) ruffable(seat int, suit int) (ruffable bool) {
+	return s.rounds(seat, suit) == 0
+}
+
+// rounds returns number of rounds of suit which opponents of seat follow before one of them could ruff.
+func (s *solver) rounds(seat int, suit int) (rounds int) {
+	rounds = 13
+	if s.trump < 0 || suit == s.trump {
+		return rounds
+	}
+	for _, opponent := range []int{(seat + 1) % 4, (seat + 3) % 4} {
+		if hand := s.hands[opponent]; hand.suit(s.trump) != 0 && hand.suit(suit).count() < rounds {
+			rounds = hand.suit(suit).count()
+		}
+	}
+	return rounds
+}
+
+// topTricks returns number of top cards in hand of seat which can be cashed without being ruffed, and the cards.
+func (s *solver) topTricks(seat int) (tricks int, cards cardSet) {
+	drawn := true
+	if s.trump >= 0 {
+		// Top trumps draw trumps of opponents, unless one of them has more trumps.
+		cards = s.topCards(seat, s.trump)
+		for _, opponent := range []int{(seat + 1) % 4, (seat + 3) % 4} {
+			if s.hands[opponent].suit(s.trump).count() > cards.count() {
+				drawn = false
+			}
+		}
+	}
+	for suit := 0; suit < 4; suit++ {
+		if suit == s.trump {
+			continue
+		}
+		top := s.topCards(seat, suit)
+		// Top cards are cashed from the highest while opponents follow.
+		for rounds := s.rounds(seat, suit); !drawn && top.count() > rounds; {
+			top &= top - 1
+		}
+		cards |= top
+	}
+	tricks = cards.count()
+
+	// Partner with trumps left has to ruff when it has no other cards, and the lead is lost.
+	if partner := s.hands[(seat+2)%4]; s.trump >= 0 && partner.suit(s.trump).count() > cards.suit(s.trump).count() {
+		trumps := cards.suit(s.trump).count()
+		if others := (partner &^ partner.suit(s.trump)).count(); tricks-trumps > others+1 {
+			tricks = trumps + others + 1
+		}
+	}
+	return tricks, cards
+}
+
+// trim returns top cards without k lowest cards of suits other than trump.
+func (s *solver) trim(cards cardSet, k int) (trimmed cardSet) {
+	for suit := 3; suit >= 0 && k > 0; suit-- {
+		for suit != s.trump && cards.suit(suit) != 0 && k > 0 {
+			cards &^= cards.suit(suit) & -cards.suit(suit)
+			k--
+		}
+	}
+	return cards
+}
+
+// topCards returns cards of suit in hand of seat which are higher than all cards of suit in other hands.
+func (s *solver) topCards(seat int, suit int) (cards cardSet) {
+	hand, rest := s.hands[seat], s.remaining().suit(suit)
+	for rest != 0 {
+		top := cardSet(1) << uint(rest.highest())
+		if hand&top == 0 {
+			break
+		}
+		cards |= top
+		rest &^= top
+	}
+	return cards
+}
+
+// trumpTricks returns seat which has the highest trump and its top trumps, which always take tricks.
+// Seat is -1 if there is no trump.
+func (s *solver) trumpTricks() (seat int, cards cardSet) {
+	all := s.remaining()
+	if s.trump < 0 || all.suit(s.trump) == 0 {
+		return -1, cards
+	}
+	top := cardSet(1) << uint(all.suit(s.trump).highest())
+	for seat = 0; s.hands[seat]&top == 0; seat++ {
+	}
+	return seat, s.topCards(seat, s.trump)
+}
+
+// solve returns number of tricks taken by North-South from the start of a trick led by leader.
+// It starts from guess and converges with null window searches. (MTD(f))
+func (s *solver) solve(leader int, guess int) (tricks int) {
+	lower, upper := 0, s.hands[leader].count()
+	tricks = guess
+	for lower < upper {
+		beta := tricks
+		if beta <= lower {
+			beta = lower + 1
+		} else if beta > upper {
+			beta = upper
+		}
+		if tricks, _ = s.search(leader, beta-1, beta); tricks < beta {
+			upper = tricks
+		} else {
+			lower = tricks
+		}
+	}
+	return lower
+}
+
+// search returns number of tricks taken by North-South from the start of a trick led by leader,
+// and cards whose ranks decide the result.
+func (s *solver) search(leader int, alpha int, beta int) (value int, relevant cardSet) {
+	n := s.hands[leader].count()
+	if n == 0 {
+		return 0, 0
+	}
+	key, position := s.key(leader)
+	lower, upper, lead := 0, n, int8(-1)
+	var lowerMask, upperMask owners
+	entries := s.tt[key]
+	for i := range entries {
+		entry := &entries[i]
+		if !entry.match(position) {
+			continue
+		}
+		if int(entry.lower) > lower {
+			lower, lowerMask = int(entry.lower), entry.mask
+		}
+		if int(entry.upper) < upper {
+			upper, upperMask = int(entry.upper), entry.mask
+		}
+		if entry.lead >= 0 {
+			lead = entry.lead
+		}
+		// Entries which cut are moved to the front.
+		if lower >= beta || upper <= alpha {
+			hit := *entry
+			copy(entries[1:i+1], entries[:i])
+			entries[0] = hit
+			break
+		}
+	}
+	lowerCards, upperCards := s.relevant(lowerMask), s.relevant(upperMask)
+	if lower < beta && upper > alpha {
+		q, cards := s.quickTricks(leader)
+		// Only tricks needed for the cut decide it.
+		need := beta
+		if leader%2 == 1 {
+			need = n - alpha
+		}
+		if q > need && q == cards.count() {
+			q, cards = need, s.trim(cards, q-need)
+		}
+		if leader%2 == 0 && q > lower {
+			lower, lowerCards = q, cards
+		} else if leader%2 == 1 && n-q < upper {
+			upper, upperCards = n-q, cards
+		}
+		if seat, cards := s.trumpTricks(); seat >= 0 {
+			if t := cards.count(); seat%2 == 0 && t > lower {
+				lower, lowerCards = t, cards
+			} else if seat%2 == 1 && n-t < upper {
+				upper, upperCards = n-t, cards
+			}
+		}
+	}
+	switch {
+	case lower >= beta:
+		return lower, lowerCards
+	case upper <= alpha:
+		return upper, upperCards
+	case lower == upper:
+		return lower, lowerCards | upperCards
+	}
+	if alpha < lower {
+		alpha = lower
+	}
+	if beta > upper {
+		beta = upper
+	}
+
+	var played [4]int
+	value, best, relevant := s.play(leader, 0, &played, alpha, beta, s.decodeLead(lead))
+
+	entry := ttEntry{mask: s.mask(relevant), lower: 0, upper: int8(n), lead: s.encodeLead(best)}
+	entry.owners = position
+	entry.owners[0] &= entry.mask[0]
+	entry.owners[1] &= entry.mask[1]
+	switch {
+	case value <= alpha:
+		entry.upper = int8(value)
+	case value >= beta:
+		entry.lower = int8(value)
+	default:
+		entry.lower, entry.upper = int8(value), int8(value)
+	}
+	s.store(key, entry)
+	// Bounds which the result reaches decide it too.
+	if value <= lower {
+		relevant |= lowerCards
+	}
+	if value >= upper {
+		relevant |= upperCards
+	}
+	return value, relevant
+}
+
+// play returns number of tricks taken by North-South when pos-th card of the trick is played,
+// index of the best card to play, and cards whose ranks decide the result.
+// Card of index first is tried first if it is not -1.
+func (s *solver) play(leader int, pos int, played *[4]int, alpha int, beta int, first int) (value int, best int, relevant cardSet) {
+	seat := (leader + pos) % 4
+	maximize := seat%2 == 0
+	if maximize {
+		value = -1
+	} else {
+		value = 1 << 30
+	}
+	var moves [13]int
+	for _, index := range moves[:s.moves(seat, leader, pos, played, &moves, first)] {
+		bit := cardSet(1) << uint(index)
+		s.hands[seat] &^= bit
+		played[pos] = index
+		var v int
+		var cards cardSet
+		if pos < 3 {
+			v, _, cards = s.play(leader, pos+1, played, alpha, beta, -1)
+		} else {
+			w := s.winner(played, 4)
+			if winner := (leader + w) % 4; winner%2 == 0 {
+				v, cards = s.search(winner, alpha-1, beta-1)
+				v++
+			} else {
+				v, cards = s.search(winner, alpha, beta)
+			}
+			// Rank of the winning card decides the trick if it beats a card of same suit.
+			for i := 0; i < 4; i++ {
+				if i != w && suitOf(played[i]) == suitOf(played[w]) {
+					cards |= 1 << uint(played[w])
+				}
+			}
+		}
+		s.hands[seat] |= bit
+		relevant |= cards
+
+		if maximize {
+			if v > value {
+				value, best = v, index
+			}
+			if value > alpha {
+				alpha = value
+			}
+		} else {
+			if v < value {
+				value, best = v, index
+			}
+			if value < beta {
+				beta = value
+			}
+		}
+		// The cut is decided by the card alone.
+		if alpha >= beta {
+			return value, best, cards
+		}
+	}
+	return value, best, relevant
+}
+
+// moves returns indexes of cards which seat should try in promising order.
+// Only the highest card of cards which are equivalent (no card in play between them) is returned.
+// Card of index first is moved to the top if it is in moves.
+// It stores moves in moves and returns number of them.
+func (s *solver) moves(seat int, leader int, pos int, played *[4]int, moves *[13]int, first int) (n int) {
+	hand := s.hands[seat]
+	inPlay := s.remaining()
+	for i := 0; i < pos; i++ {
+		inPlay |= 1 << uint(played[i])
+	}
+	if pos > 0 {
+		if follow := hand.suit(suitOf(played[0])); follow != 0 {
+			hand = follow
+		}
+	}
+
+	for suit := 0; suit < 4; suit++ {
+		cards := hand.suit(suit)
+		last := -1
+		for cards != 0 {
+			index := 63 - bits.LeadingZeros64(uint64(cards))
+			cards &^= 1 << uint(index)
+			between := inPlay & ((cardSet(1)<<uint(last) - 1) &^ (cardSet(1)<<uint(index+1) - 1))
+			if last >= 0 && between == 0 {
+				last = index
+				continue
+			}
+			last = index
+			moves[n] = index
+			n++
+		}
+	}
+	if n == 1 {
+		return n
+	}
+
+	var scores [13]int
+	for i := 0; i < n; i++ {
+		scores[i] = s.score(moves[i], seat, leader, pos, played, inPlay)
+	}
+	for i := 1; i < n; i++ {
+		for j := i; j > 0 && scores[j] > scores[j-1]; j-- {
+			moves[j], moves[j-1] = moves[j-1], moves[j]
+			scores[j], scores[j-1] = scores[j-1], scores[j]
+		}
+	}
+	for i := 1; i < n; i++ {
+		if moves[i] == first {
+			copy(moves[1:i+1], moves[:i])
+			moves[0] = first
+		}
+	}
+	return n
+}
+
+// score returns priority of card to try for move ordering.
+// Leader tries to cash winners, to reach partner's winners or to give partner a ruff.
+// Followers try to win the trick as cheaply as possible, or play the lowest card when it is hopeless
+// or partner is surely winning.
+func (s *solver) score(index int, seat int, leader int, pos int, played *[4]int, inPlay cardSet) (score int) {
+	suit, rank := suitOf(index), index%16
+	partner := (seat + 2) % 4
+	if pos == 0 {
+		top := inPlay.suit(suit).highest()
+		switch {
+		case top == index:
+			return 100 + rank
+		case s.hands[partner]&(1<<uint(top)) != 0:
+			return 90 - rank
+		case s.trump >= 0 && suit != s.trump && s.hands[partner].suit(suit) == 0 && s.hands[partner].suit(s.trump) != 0:
+			return 80 - rank
+		default:
+			return 50 - rank
+		}
+	}
+	if suit == s.trump && suitOf(played[0]) != s.trump {
+		rank -= 20
+	}
+	played[pos] = index
+	led := suitOf(played[0])
+	best := s.winner(played, pos)
+	winning := s.winner(played, pos+1) == pos
+	fourth := (leader + 3) % 4
+	switch {
+	case (leader+best)%2 == seat%2 && (pos == 3 || s.safe(played[best], fourth, led)):
+		return -rank
+	case winning && (pos == 3 || s.safe(index, fourth, led)):
+		return 100 - rank
+	case winning && pos == 1 && index == inPlay.suit(suit).highest():
+		return 100 - rank
+	case winning && pos == 2:
+		return 60 + rank
+	default:
+		return -rank
+	}
+}
+
+// safe returns whether card winning the trick led with suit led can't be beaten by seat.
+func (s *solver) safe(card int, seat int, led int) (safe bool) {
+	hand := s.hands[seat]
+	if follow := hand.suit(led); follow != 0 {
+		return suitOf(card) != led || follow.highest() < card
+	}
+	if s.trump < 0 || hand.suit(s.trump) == 0 {
+		return true
+	}
+	return suitOf(card) == s.trump && hand.suit(s.trump).highest() < card
+}
+
+// winner returns position of the card which wins in the first n cards of the trick.
+func (s *solver) winner(played *[4]int, n int) (pos int) {
+	for i := 1; i < n; i++ {
+		best, card := played[pos], played[i]
+		switch {
+		case suitOf(card) == suitOf(best) && card > best:
+			pos = i
+		case suitOf(card) == s.trump && suitOf(best) != s.trump:
+			pos = i
+		}
+	}
+	return pos
+}
+
+// toHands converts hands to bit sets, and returns error if hands are not valid for double dummy solver.
+func toHands(hands [4]gocard.Cards) (sets [4]cardSet, err error) {
+	if err = (Deal{Hands: hands}).Validate(); err != nil {
+		return sets, err
+	}
+	for seat, hand := range hands {
+		if len(hand) != len(hands[0]) {
+			err = errors.New("couldn't solve, hands have different number of cards")
+			return sets, err
+		}
+		sets[seat] = toCardSet(hand)
+	}
+	return sets, err
+}
+
+// Solve returns number of tricks taken by side of leader with perfect play of all players,
+// all hands are visible. (double dummy)
+// Hands must have same number of cards, and it returns error of invalid hands.
+func Solve(hands [4]gocard.Cards, strain Strain, leader Seat) (tricks int, err error) {
+	sets, err := toHands(hands)
+	if err != nil {
+		return tricks, err
+	}
+	if strain < CLUBS || strain > NOTRUMP {
+		err = fmt.Errorf("couldn't solve, %d is not strain", strain)
+		return tricks, err
+	}
+	if leader < NORTH || leader > WEST {
+		err = fmt.Errorf("couldn't solve, %d is not seat", leader)
+		return tricks, err
+	}
+	n := len(hands[0])
+	tricks = newSolver(sets, strain).solve(int(leader), n/2)
+	if leader.Side() == EW {
+		tricks = n - tricks
+	}
+	return tricks, err
+}
+
+// TrickTable is number of tricks taken by declarer for each strain and declarer seat.
+// (e.g. table[SPADES][SOUTH] is tricks taken by South as declarer in Spades)
+type TrickTable [5][4]int
+
+// SolveDeal returns tricks taken by each declarer in each strain with double dummy analysis.
+// Strains are solved in parallel, and declarers of a strain share positions already solved.
+func SolveDeal(deal Deal) (table TrickTable, err error) {
+	sets, err := toHands(deal.Hands)
+	if err != nil {
+		return table, err
+	}
+	n := len(deal.Hands[0])
+
+	strains := make(chan Strain)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for strain := range strains {
+				s := newSolver(sets, strain)
+				// Leads of partners often give same tricks, so the first result is the next guess.
+				var ns [4]int
+				for _, declarer := range []Seat{NORTH, EAST, SOUTH, WEST} {
+					leader := int(declarer.Next())
+					guess := n / 2
+					if declarer >= SOUTH {
+						guess = ns[(leader+2)%4]
+					}
+					ns[leader] = s.solve(leader, guess)
+					if declarer.Side() == NS {
+						table[strain][declarer] = ns[leader]
+					} else {
+						table[strain][declarer] = n - ns[leader]
+					}
+				}
+			}
+		}()
+	}
+	for strain := CLUBS; strain <= NOTRUMP; strain++ {
+		strains <- strain
+	}
+	close(strains)
+	wg.Wait()
+	return table, err
+}
@@ -0,0 +1,151 @@
+package bridge
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// For test
+func spades(ranks ...gocard.Rank) (cards gocard.Cards) {
+	for _, rank := range ranks {
+		cards = append(cards, gocard.Card{Rank: rank, Suit: gocard.SPADES})
+	}
+	return cards
+}
+
+// For test
+func hearts(ranks ...gocard.Rank) (cards gocard.Cards) {
+	for _, rank := range ranks {
+		cards = append(cards, gocard.Card{Rank: rank, Suit: gocard.HEARTS})
+	}
+	return cards
+}
+
+// #################################
+// Test Solve()
+// #################################
+
+func TestSolveFinesse(t *testing.T) {
+	hands := [4]gocard.Cards{
+		spades(gocard.ACE, gocard.QUEEN),
+		hearts(gocard.TWO, gocard.THREE),
+		spades(gocard.TWO, gocard.THREE),
+		spades(gocard.KING, gocard.FOUR),
+	}
+	testCases := map[Seat]int{
+		SOUTH: 2,
+		NORTH: 1,
+		EAST:  2,
+		WEST:  0,
+	}
+	for leader, expected := range testCases {
+		actual, err := Solve(hands, NOTRUMP, leader)
+		if err != nil || actual != expected {
+			msg := "Tricks of side of " + leader.String() + " are not expected tricks"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+		}
+	}
+}
+
+func TestSolveRuff(t *testing.T) {
+	hands := [4]gocard.Cards{
+		spades(gocard.ACE, gocard.KING),
+		hearts(gocard.TWO, gocard.THREE),
+		spades(gocard.TWO, gocard.THREE),
+		spades(gocard.FOUR, gocard.FIVE),
+	}
+	if tricks, err := Solve(hands, NOTRUMP, NORTH); err != nil || tricks != 2 {
+		expected := 2
+		actual := tricks
+		msg := "Expected top cards take all tricks in no-trump, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if tricks, err := Solve(hands, HEARTS, NORTH); err != nil || tricks != 0 {
+		expected := 0
+		actual := tricks
+		msg := "Expected East ruffs top cards, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestSolveInvalidHands(t *testing.T) {
+	hands := [4]gocard.Cards{
+		spades(gocard.ACE, gocard.KING),
+		hearts(gocard.TWO),
+		spades(gocard.TWO, gocard.THREE),
+		spades(gocard.FOUR, gocard.FIVE),
+	}
+	if _, err := Solve(hands, NOTRUMP, NORTH); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as solving hands with different number of cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test SolveDeal()
+// #################################
+
+// Deals and tables are from examples of DDS by Bo Haglund (hands.cpp).
+var solveDealCases = []struct {
+	pbn   string
+	table TrickTable
+}{
+	{
+		"N:QJ6.K652.J85.T98 873.J97.AT764.Q4 K5.T83.KQ9.A7652 AT942.AQ4.32.KJ3",
+		TrickTable{{7, 5, 7, 5}, {5, 7, 5, 7}, {6, 6, 6, 6}, {5, 8, 5, 8}, {6, 6, 6, 6}},
+	},
+	{
+		"E:QJT5432.T.6.QJ82 .J97543.K7532.94 87.A62.QJT4.AT75 AK96.KQ8.A98.K63",
+		TrickTable{{6, 7, 6, 7}, {8, 3, 8, 3}, {10, 2, 10, 2}, {4, 9, 4, 9}, {9, 3, 9, 3}},
+	},
+	{
+		"N:73.QJT.AQ54.T752 QT6.876.KJ9.AQ84 5.A95432.7632.K6 AKJ9842.K.T8.J93",
+		TrickTable{{3, 9, 3, 9}, {8, 4, 8, 4}, {9, 4, 9, 4}, {3, 10, 3, 10}, {4, 8, 4, 8}},
+	},
+}
+
+func TestSolveDeal(t *testing.T) {
+	for _, testCase := range solveDealCases {
+		hands, err := parsePBNDeal(testCase.pbn)
+		if err != nil {
+			t.Fatalf("Couldn't parse deal %s\nError: %v", testCase.pbn, err)
+		}
+		table, err := SolveDeal(Deal{Hands: hands})
+		if err != nil {
+			t.Fatalf("Couldn't solve deal %s\nError: %v", testCase.pbn, err)
+		}
+		for strain := CLUBS; strain <= NOTRUMP; strain++ {
+			for declarer := NORTH; declarer <= WEST; declarer++ {
+				expected := testCase.table[strain][declarer]
+				if actual := table[strain][declarer]; actual != expected {
+					msg := "Tricks of " + declarer.String() + " in " + strain.String() + " of " + testCase.pbn + " are not expected tricks"
+					t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveFullDeal(t *testing.T) {
+	testCase := solveDealCases[0]
+	hands, _ := parsePBNDeal(testCase.pbn)
+	// East leads against spades of North, so East-West take tricks which North doesn't take.
+	expected := 13 - testCase.table[SPADES][NORTH]
+	if actual, err := Solve(hands, SPADES, EAST); err != nil || actual != expected {
+		msg := "Tricks of side of East are not expected tricks"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func BenchmarkSolveDeal(b *testing.B) {
+	hands, _ := parsePBNDeal(solveDealCases[0].pbn)
+	deal := Deal{Hands: hands}
+	for i := 0; i < b.N; i++ {
+		if _, err := SolveDeal(deal); err != nil {
+			b.Fatalf("Couldn't solve deal\nError: %v", err)
+		}
+	}
+}
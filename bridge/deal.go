@@ -0,0 +1,92 @@
+package bridge
+
+import (
+	"fmt"
+	"strings"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Deal is a deal of bridge. Hands is hands of North, East, South and West.
+type Deal struct {
+	Board         int
+	Dealer        Seat
+	Vulnerability Vulnerability
+	Hands         [4]gocard.Cards
+}
+
+// ranks is ranks in the order used by deal files. (Ace ~ Two)
+var ranks = []gocard.Rank{
+	gocard.ACE, gocard.KING, gocard.QUEEN, gocard.JACK, gocard.TEN, gocard.NINE, gocard.EIGHT,
+	gocard.SEVEN, gocard.SIX, gocard.FIVE, gocard.FOUR, gocard.THREE, gocard.TWO,
+}
+
+const rankChars = "AKQJT98765432"
+
+const suitChars = "SHDC"
+
+// parseRank returns rank of character. (e.g. 'T' => Ten)
+func parseRank(c byte) (rank gocard.Rank, err error) {
+	i := strings.IndexByte(rankChars, c)
+	if i < 0 {
+		err = fmt.Errorf("couldn't parse rank %q", c)
+		return rank, err
+	}
+	return ranks[i], err
+}
+
+// formatSuit returns ranks of cards of suit in descending order. (e.g. AKT2)
+func formatSuit(hand gocard.Cards, suit gocard.Suit) (msg string) {
+	var b strings.Builder
+	for i, rank := range ranks {
+		for _, card := range hand {
+			if card.Suit == suit && card.Rank == rank {
+				b.WriteByte(rankChars[i])
+			}
+		}
+	}
+	return b.String()
+}
+
+// seatOfChar returns seat of character. (e.g. 'N' => North)
+func seatOfChar(c byte) (seat Seat, err error) {
+	i := strings.IndexByte("NESW", c)
+	if i < 0 {
+		err = fmt.Errorf("couldn't parse seat %q", c)
+		return seat, err
+	}
+	return Seat(i), err
+}
+
+// Validate returns error if a card is dealt twice or a hand has more than 13 cards.
+func (deal Deal) Validate() (err error) {
+	seen := map[gocard.Card]bool{}
+	for seat, hand := range deal.Hands {
+		if len(hand) > 13 {
+			return fmt.Errorf("invalid deal, %s has %d cards", Seat(seat), len(hand))
+		}
+		for _, card := range hand {
+			if seen[card] {
+				return fmt.Errorf("invalid deal, %s is dealt twice", card)
+			}
+			seen[card] = true
+		}
+	}
+	return err
+}
+
+// remaining returns cards which are not dealt to any hand.
+func (deal Deal) remaining() (cards gocard.Cards) {
+	seen := map[gocard.Card]bool{}
+	for _, hand := range deal.Hands {
+		for _, card := range hand {
+			seen[card] = true
+		}
+	}
+	for _, card := range gocard.NewDeck() {
+		if !seen[card] {
+			cards = append(cards, card)
+		}
+	}
+	return cards
+}
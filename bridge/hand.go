@@ -0,0 +1,88 @@
+package bridge
+
+import (
+	"fmt"
+	"sort"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Evaluation is evaluation of a 13-card hand.
+// Distribution is number of cards in Spades, Hearts, Diamonds and Clubs.
+type Evaluation struct {
+	HCP                int
+	Distribution       [4]int
+	DistributionPoints int
+	Balanced           bool
+}
+
+// Points returns total of high card points and distribution points.
+func (evaluation Evaluation) Points() (points int) {
+	return evaluation.HCP + evaluation.DistributionPoints
+}
+
+// Shape returns lengths of suits in descending order. (e.g. [5 3 3 2])
+func (evaluation Evaluation) Shape() (shape [4]int) {
+	shape = evaluation.Distribution
+	sort.Sort(sort.Reverse(sort.IntSlice(shape[:])))
+	return shape
+}
+
+// HCP returns high card points of cards. (Ace: 4, King: 3, Queen: 2, Jack: 1)
+func HCP(cards gocard.Cards) (points int) {
+	for _, card := range cards {
+		switch card.Rank {
+		case gocard.ACE:
+			points += 4
+		case gocard.KING:
+			points += 3
+		case gocard.QUEEN:
+			points += 2
+		case gocard.JACK:
+			points++
+		}
+	}
+	return points
+}
+
+// Distribution returns number of cards in Spades, Hearts, Diamonds and Clubs.
+func Distribution(cards gocard.Cards) (distribution [4]int) {
+	for _, card := range cards {
+		for i, suit := range suits {
+			if card.Suit == suit {
+				distribution[i]++
+			}
+		}
+	}
+	return distribution
+}
+
+// Evaluate evaluates a 13-card hand, and returns error of invalid hand.
+// Distribution points are 3 for a void, 2 for a singleton and 1 for a doubleton.
+// Hand is balanced if it is 4-3-3-3, 4-4-3-2 or 5-3-3-2.
+func Evaluate(hand gocard.Cards) (evaluation Evaluation, err error) {
+	if len(hand) != 13 {
+		err = fmt.Errorf("couldn't evaluate, hand has %d cards", len(hand))
+		return evaluation, err
+	}
+	seen := map[gocard.Card]bool{}
+	for _, card := range hand {
+		if seen[card] {
+			err = fmt.Errorf("couldn't evaluate, %s is in hand twice", card)
+			return evaluation, err
+		}
+		seen[card] = true
+	}
+	evaluation.HCP = HCP(hand)
+	evaluation.Distribution = Distribution(hand)
+	for _, length := range evaluation.Distribution {
+		if length < 3 {
+			evaluation.DistributionPoints += 3 - length
+		}
+	}
+	switch evaluation.Shape() {
+	case [4]int{4, 3, 3, 3}, [4]int{4, 4, 3, 2}, [4]int{5, 3, 3, 2}:
+		evaluation.Balanced = true
+	}
+	return evaluation, err
+}
@@ -0,0 +1,81 @@
+package bridge
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupHand() (hand gocard.Cards) {
+	ranks := map[gocard.Suit][]gocard.Rank{
+		gocard.SPADES:   {gocard.ACE, gocard.KING, gocard.FIVE, gocard.TWO},
+		gocard.HEARTS:   {gocard.QUEEN, gocard.JACK, gocard.TEN},
+		gocard.DIAMONDS: {gocard.ACE, gocard.NINE, gocard.FOUR, gocard.THREE, gocard.TWO},
+		gocard.CLUBS:    {gocard.SEVEN},
+	}
+	for suit, rs := range ranks {
+		for _, rank := range rs {
+			hand = append(hand, gocard.Card{Rank: rank, Suit: suit})
+		}
+	}
+	return hand
+}
+
+// #################################
+// Test Evaluate()
+// #################################
+
+func TestEvaluate(t *testing.T) {
+	evaluation, err := Evaluate(setupHand())
+	if err != nil {
+		t.Fatalf("Couldn't evaluate hand\nError: %v", err)
+	}
+
+	expected := Evaluation{HCP: 14, Distribution: [4]int{4, 3, 5, 1}, DistributionPoints: 2}
+	if evaluation != expected {
+		actual := evaluation
+		msg := "Evaluation of hand is not expected evaluation"
+		t.Fatalf("%s\nExpected: %+v\nActual  : %+v", msg, expected, actual)
+	}
+	if shape := evaluation.Shape(); shape != [4]int{5, 4, 3, 1} {
+		expected := [4]int{5, 4, 3, 1}
+		actual := shape
+		msg := "Shape of hand is not expected shape"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestEvaluateBalanced(t *testing.T) {
+	hand := setupHand()
+	for i, card := range hand {
+		if card.Suit == gocard.DIAMONDS && card.Rank == gocard.TWO {
+			hand[i] = gocard.Card{Rank: gocard.KING, Suit: gocard.CLUBS}
+		}
+	}
+
+	if evaluation, err := Evaluate(hand); err != nil || !evaluation.Balanced {
+		expected := true
+		actual := evaluation
+		msg := "Expected 4-4-3-2 hand is balanced, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %+v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestEvaluateInvalidHand(t *testing.T) {
+	hand := setupHand()
+	hand[0] = hand[1]
+
+	if _, err := Evaluate(hand); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as evaluating hand with same cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if _, err := Evaluate(hand[:12]); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as evaluating 12-card hand"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
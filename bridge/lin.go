@@ -0,0 +1,159 @@
+package bridge
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// linSeats is seats in the order of hands in LIN. (South, West, North, East)
+var linSeats = []Seat{SOUTH, WEST, NORTH, EAST}
+
+var linVulnerabilities = map[Vulnerability]string{
+	NONE:  "o",
+	NSVUL: "n",
+	EWVUL: "e",
+	BOTH:  "b",
+}
+
+// ReadLIN reads deals from BBO LIN format and returns error of invalid format.
+// Each md (make deal) command starts a deal. Commands except md, sv, ah and qx are ignored.
+// If hand of East is omitted, remaining cards are dealt to East.
+// Deal without qx or ah has next board number of the previous deal, and the first deal is board 1.
+func ReadLIN(r io.Reader) (deals []Deal, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return deals, err
+	}
+	text := strings.NewReplacer("\r", "", "\n", "").Replace(string(data))
+	tokens := strings.Split(text, "|")
+	// labeled is whether board is set by qx or ah for the next deal,
+	// and dealLabeled is whether board of the last deal was set before its md.
+	board, labeled, dealLabeled := 0, false, false
+	for i := 0; i+1 < len(tokens); i += 2 {
+		command, value := strings.ToLower(strings.TrimSpace(tokens[i])), strings.TrimSpace(tokens[i+1])
+		var deal *Deal
+		if len(deals) > 0 {
+			deal = &deals[len(deals)-1]
+		}
+		switch command {
+		case "qx":
+			if n, err := strconv.Atoi(strings.TrimLeft(value, "oOcC")); err == nil {
+				board, labeled = n, true
+			}
+		case "ah":
+			n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(value, "Board")))
+			if err != nil {
+				return deals, fmt.Errorf("couldn't parse board %q", value)
+			}
+			// ah after md labels the deal if it has no board yet, otherwise it labels the next deal.
+			if deal != nil && !dealLabeled {
+				deal.Board, dealLabeled = n, true
+			} else {
+				labeled = true
+			}
+			board = n
+		case "md":
+			parsed, err := parseLINDeal(value)
+			if err != nil {
+				return deals, err
+			}
+			// Deal without qx or ah follows the board of the previous deal.
+			if !labeled {
+				board = 1
+				if deal != nil {
+					board = deal.Board + 1
+				}
+			}
+			parsed.Board = board
+			deals = append(deals, parsed)
+			dealLabeled, labeled = labeled, false
+		case "sv":
+			if deal == nil {
+				continue
+			}
+			switch strings.ToLower(value) {
+			case "o", "0", "":
+				deal.Vulnerability = NONE
+			case "n":
+				deal.Vulnerability = NSVUL
+			case "e":
+				deal.Vulnerability = EWVUL
+			case "b":
+				deal.Vulnerability = BOTH
+			default:
+				return deals, fmt.Errorf("couldn't parse vulnerability %q", value)
+			}
+		}
+	}
+	for _, deal := range deals {
+		if err = deal.Validate(); err != nil {
+			return deals, err
+		}
+	}
+	return deals, err
+}
+
+// parseLINDeal parses value of md command. (e.g. 3SAKQHJT9D876C5432,S...,S...,)
+func parseLINDeal(value string) (deal Deal, err error) {
+	if len(value) < 1 || value[0] < '1' || value[0] > '4' {
+		err = fmt.Errorf("couldn't parse dealer of deal %q", value)
+		return deal, err
+	}
+	deal.Dealer = linSeats[value[0]-'1']
+	fields := strings.Split(value[1:], ",")
+	if len(fields) > 4 {
+		err = fmt.Errorf("couldn't parse deal %q, it has more than 4 hands", value)
+		return deal, err
+	}
+	for i, field := range fields {
+		seat := linSeats[i]
+		var suit gocard.Suit
+		for j := 0; j < len(field); j++ {
+			if k := strings.IndexByte(suitChars, field[j]); k >= 0 {
+				suit = suits[k]
+				continue
+			}
+			rank, err := parseRank(field[j])
+			if err != nil || suit == 0 {
+				err = fmt.Errorf("couldn't parse hand %q", field)
+				return deal, err
+			}
+			deal.Hands[seat] = append(deal.Hands[seat], gocard.Card{Rank: rank, Suit: suit})
+		}
+	}
+	if len(deal.Hands[SOUTH]) == 13 && len(deal.Hands[WEST]) == 13 && len(deal.Hands[NORTH]) == 13 && len(deal.Hands[EAST]) == 0 {
+		deal.Hands[EAST] = deal.remaining()
+	}
+	return deal, err
+}
+
+// WriteLIN writes deals in BBO LIN format, a deal per line.
+func WriteLIN(w io.Writer, deals []Deal) (err error) {
+	for _, deal := range deals {
+		fields := make([]string, 4)
+		for i, seat := range linSeats {
+			var b strings.Builder
+			for j, suit := range suits {
+				b.WriteByte(suitChars[j])
+				b.WriteString(formatSuit(deal.Hands[seat], suit))
+			}
+			fields[i] = b.String()
+		}
+		dealer := 0
+		for i, seat := range linSeats {
+			if seat == deal.Dealer {
+				dealer = i + 1
+			}
+		}
+		_, err = fmt.Fprintf(w, "qx|o%d|md|%d%s|rh||ah|Board %d|sv|%s|pg||\n",
+			deal.Board, dealer, strings.Join(fields, ","), deal.Board, linVulnerabilities[deal.Vulnerability])
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+package bridge
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// #################################
+// Test ReadLIN()
+// #################################
+
+func TestReadLIN(t *testing.T) {
+	lin := "pn|a,b,c,d|st||md|3SAKQJHT98D765C432,S5432HAKQDJT9C876,ST98H765D432CAKQJ,|rh||ah|Board 5|sv|n|pg||\n"
+	deals, err := ReadLIN(strings.NewReader(lin))
+	if err != nil || len(deals) != 1 {
+		t.Fatalf("Couldn't read LIN\nError: %v\nDeals: %v", err, deals)
+	}
+
+	deal := deals[0]
+	if deal.Board != 5 || deal.Dealer != NORTH || deal.Vulnerability != NSVUL {
+		expected := "Board 5, North, NS"
+		actual := deal
+		msg := "Deal is not expected deal"
+		t.Fatalf("%s\nExpected: %v\nActual  : %+v", msg, expected, actual)
+	}
+	if len(deal.Hands[EAST]) != 13 {
+		expected := 13
+		actual := len(deal.Hands[EAST])
+		msg := "Expected remaining cards are dealt to East, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestReadLINBoardBeforeDeal(t *testing.T) {
+	md := "md|3SAKQJHT98D765C432,S5432HAKQDJT9C876,ST98H765D432CAKQJ,|"
+	lin := "ah|Board 1|" + md + "ah|Board 2|" + md
+	deals, err := ReadLIN(strings.NewReader(lin))
+	if err != nil || len(deals) != 2 {
+		t.Fatalf("Couldn't read LIN\nError: %v\nDeals: %v", err, deals)
+	}
+
+	if deals[0].Board != 1 || deals[1].Board != 2 {
+		expected := []int{1, 2}
+		actual := []int{deals[0].Board, deals[1].Board}
+		msg := "Expected ah before md labels the next deal, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+
+	deals, err = ReadLIN(strings.NewReader(md + "ah|Board 5|" + md + "ah|Board 6|"))
+	if err != nil || len(deals) != 2 || deals[0].Board != 5 || deals[1].Board != 6 {
+		expected := []int{5, 6}
+		actual := deals
+		msg := "Expected ah after md labels the deal, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestReadLINUnlabeledDeals(t *testing.T) {
+	md := "md|3SAKQJHT98D765C432,S5432HAKQDJT9C876,ST98H765D432CAKQJ,|"
+	sequences := map[string][]int{
+		"qx|o1|" + md + md:      {1, 2},
+		md + md:                 {1, 2},
+		md + "ah|Board 2|" + md: {2, 3},
+		"ah|Board 7|" + md + md: {7, 8},
+	}
+	for lin, expected := range sequences {
+		deals, err := ReadLIN(strings.NewReader(lin))
+		if err != nil || len(deals) != 2 || deals[0].Board != expected[0] || deals[1].Board != expected[1] {
+			actual := deals
+			msg := "Expected deal without board follows the previous board, but not"
+			t.Fatalf("%s (%s)\nExpected: %v\nActual  : %v (%v)", msg, lin, expected, actual, err)
+		}
+	}
+}
+
+func TestReadLINInvalidDealer(t *testing.T) {
+	if _, err := ReadLIN(strings.NewReader("md|5SA,,,|")); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as reading deal with invalid dealer"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test WriteLIN()
+// #################################
+
+func TestWriteLIN(t *testing.T) {
+	deals := []Deal{
+		{Board: 3, Dealer: SOUTH, Vulnerability: EWVUL, Hands: setupHands()},
+		{Board: 4, Dealer: WEST, Vulnerability: BOTH, Hands: setupHands()},
+	}
+	var buf bytes.Buffer
+	if err := WriteLIN(&buf, deals); err != nil {
+		t.Fatalf("Couldn't write LIN\nError: %v", err)
+	}
+
+	read, err := ReadLIN(&buf)
+	if err != nil || len(read) != 2 {
+		t.Fatalf("Couldn't read written LIN\nError: %v", err)
+	}
+	for i := range deals {
+		checkSameDeal(t, deals[i], read[i])
+	}
+}
@@ -0,0 +1,187 @@
+package bridge
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// ReadPBN reads deals from PBN (Portable Bridge Notation) and returns error of invalid format.
+// Tags except Board, Dealer, Vulnerable and Deal are ignored.
+func ReadPBN(r io.Reader) (deals []Deal, err error) {
+	scanner := bufio.NewScanner(r)
+	var deal *Deal
+	inComment := false
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if inComment {
+			inComment = !strings.Contains(line, "}")
+			continue
+		}
+		switch {
+		case line == "":
+			deal = nil
+			continue
+		case strings.HasPrefix(line, "%"), strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "{"):
+			inComment = !strings.Contains(line, "}")
+			continue
+		case !strings.HasPrefix(line, "["):
+			continue
+		}
+		tag, value, err := parsePBNTag(line)
+		if err != nil {
+			return deals, fmt.Errorf("line %d: %v", n, err)
+		}
+		if deal == nil {
+			deals = append(deals, Deal{})
+			deal = &deals[len(deals)-1]
+		}
+		if err = deal.setPBNTag(tag, value); err != nil {
+			return deals, fmt.Errorf("line %d: %v", n, err)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return deals, err
+	}
+	for _, deal := range deals {
+		if err = deal.Validate(); err != nil {
+			return deals, err
+		}
+	}
+	return deals, err
+}
+
+// parsePBNTag parses tag pair. (e.g. [Dealer "N"])
+func parsePBNTag(line string) (tag string, value string, err error) {
+	if !strings.HasSuffix(line, "]") {
+		err = fmt.Errorf("couldn't parse tag %s", line)
+		return tag, value, err
+	}
+	fields := strings.SplitN(strings.TrimSpace(line[1:len(line)-1]), " ", 2)
+	if len(fields) != 2 {
+		err = fmt.Errorf("couldn't parse tag %s", line)
+		return tag, value, err
+	}
+	value, err = strconv.Unquote(strings.TrimSpace(fields[1]))
+	if err != nil {
+		err = fmt.Errorf("couldn't parse value of tag %s", line)
+	}
+	return fields[0], value, err
+}
+
+func (deal *Deal) setPBNTag(tag string, value string) (err error) {
+	switch tag {
+	case "Board":
+		deal.Board, err = strconv.Atoi(value)
+		if err != nil {
+			err = fmt.Errorf("couldn't parse board %q", value)
+		}
+	case "Dealer":
+		if len(value) != 1 {
+			return fmt.Errorf("couldn't parse dealer %q", value)
+		}
+		deal.Dealer, err = seatOfChar(value[0])
+	case "Vulnerable":
+		switch value {
+		case "None", "Love", "-":
+			deal.Vulnerability = NONE
+		case "NS":
+			deal.Vulnerability = NSVUL
+		case "EW":
+			deal.Vulnerability = EWVUL
+		case "All", "Both":
+			deal.Vulnerability = BOTH
+		default:
+			err = fmt.Errorf("couldn't parse vulnerability %q", value)
+		}
+	case "Deal":
+		deal.Hands, err = parsePBNDeal(value)
+	}
+	return err
+}
+
+// parsePBNDeal parses hands of deal. (e.g. N:AKQ.JT9.876.5432 ...)
+func parsePBNDeal(value string) (hands [4]gocard.Cards, err error) {
+	if len(value) < 2 || value[1] != ':' {
+		err = fmt.Errorf("couldn't parse deal %q", value)
+		return hands, err
+	}
+	first, err := seatOfChar(value[0])
+	if err != nil {
+		return hands, err
+	}
+	fields := strings.Fields(value[2:])
+	if len(fields) != 4 {
+		err = fmt.Errorf("couldn't parse deal %q, it must have 4 hands", value)
+		return hands, err
+	}
+	for i, field := range fields {
+		seat := (first + Seat(i)) % 4
+		if field == "-" {
+			continue
+		}
+		holdings := strings.Split(field, ".")
+		if len(holdings) != 4 {
+			err = fmt.Errorf("couldn't parse hand %q, it must have 4 suits", field)
+			return hands, err
+		}
+		for j, holding := range holdings {
+			for k := 0; k < len(holding); k++ {
+				rank, err := parseRank(holding[k])
+				if err != nil {
+					return hands, err
+				}
+				hands[seat] = append(hands[seat], gocard.Card{Rank: rank, Suit: suits[j]})
+			}
+		}
+	}
+	return hands, err
+}
+
+// formatPBNDeal returns hands of deal in PBN format starting from dealer.
+func formatPBNDeal(deal Deal) (value string) {
+	fields := make([]string, 4)
+	for i := range fields {
+		hand := deal.Hands[(deal.Dealer+Seat(i))%4]
+		if len(hand) == 0 {
+			fields[i] = "-"
+			continue
+		}
+		holdings := make([]string, 4)
+		for j, suit := range suits {
+			holdings[j] = formatSuit(hand, suit)
+		}
+		fields[i] = strings.Join(holdings, ".")
+	}
+	return fmt.Sprintf("%c:%s", "NESW"[deal.Dealer], strings.Join(fields, " "))
+}
+
+var pbnVulnerabilities = map[Vulnerability]string{
+	NONE:  "None",
+	NSVUL: "NS",
+	EWVUL: "EW",
+	BOTH:  "All",
+}
+
+// WritePBN writes deals in PBN (Portable Bridge Notation).
+func WritePBN(w io.Writer, deals []Deal) (err error) {
+	for i, deal := range deals {
+		if i > 0 {
+			if _, err = fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintf(w, "[Board \"%d\"]\n[Dealer \"%c\"]\n[Vulnerable \"%s\"]\n[Deal \"%s\"]\n",
+			deal.Board, "NESW"[deal.Dealer], pbnVulnerabilities[deal.Vulnerability], formatPBNDeal(deal))
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,114 @@
+package bridge
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test ReadPBN()
+// #################################
+
+func TestReadPBN(t *testing.T) {
+	pbn := `% PBN 2.1
+[Event "Club"]
+[Board "7"]
+[Dealer "S"]
+[Vulnerable "EW"]
+[Deal "N:AKQJ.T98.765.432 - 5432.AKQ.JT9.876 -"]
+
+[Board "8"]
+[Dealer "W"]
+[Vulnerable "All"]
+[Deal "W:- - - -"]
+`
+	deals, err := ReadPBN(strings.NewReader(pbn))
+	if err != nil || len(deals) != 2 {
+		t.Fatalf("Couldn't read PBN\nError: %v\nDeals: %v", err, deals)
+	}
+
+	deal := deals[0]
+	if deal.Board != 7 || deal.Dealer != SOUTH || deal.Vulnerability != EWVUL {
+		expected := "Board 7, South, EW"
+		actual := deal
+		msg := "Tags of deal are not expected tags"
+		t.Fatalf("%s\nExpected: %v\nActual  : %+v", msg, expected, actual)
+	}
+	north := deal.Hands[NORTH]
+	if len(north) != 13 || north[0] != (gocard.Card{Rank: gocard.ACE, Suit: gocard.SPADES}) || len(deal.Hands[EAST]) != 0 {
+		expected := "13 cards starting with Ace of Spades"
+		actual := north
+		msg := "Hand of North is not expected hand"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if deals[1].Board != 8 || deals[1].Vulnerability != BOTH {
+		expected := "Board 8, All"
+		actual := deals[1]
+		msg := "Second deal is not expected deal"
+		t.Fatalf("%s\nExpected: %v\nActual  : %+v", msg, expected, actual)
+	}
+}
+
+func TestReadPBNInvalidDeal(t *testing.T) {
+	pbn := `[Deal "N:AKQJ.T98.765.432 AKQJ.T98.765.432 - -"]`
+	if _, err := ReadPBN(strings.NewReader(pbn)); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as reading deal with same cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test WritePBN()
+// #################################
+
+func TestWritePBN(t *testing.T) {
+	deals := []Deal{
+		{Board: 1, Dealer: NORTH, Vulnerability: NONE, Hands: setupHands()},
+		{Board: 2, Dealer: EAST, Vulnerability: NSVUL, Hands: setupHands()},
+	}
+	var buf bytes.Buffer
+	if err := WritePBN(&buf, deals); err != nil {
+		t.Fatalf("Couldn't write PBN\nError: %v", err)
+	}
+	if !strings.Contains(buf.String(), `[Deal "E:`) {
+		expected := `[Deal "E:...`
+		actual := buf.String()
+		msg := "Deal is not written from dealer"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+
+	read, err := ReadPBN(&buf)
+	if err != nil || len(read) != 2 {
+		t.Fatalf("Couldn't read written PBN\nError: %v", err)
+	}
+	for i := range deals {
+		checkSameDeal(t, deals[i], read[i])
+	}
+}
+
+// For test
+func checkSameDeal(t *testing.T, expected Deal, actual Deal) {
+	t.Helper()
+	if expected.Board != actual.Board || expected.Dealer != actual.Dealer || expected.Vulnerability != actual.Vulnerability {
+		t.Fatalf("Deal is not same deal\nExpected: %+v\nActual  : %+v", expected, actual)
+	}
+	for seat := range expected.Hands {
+		cards := map[gocard.Card]bool{}
+		for _, card := range expected.Hands[seat] {
+			cards[card] = true
+		}
+		if len(actual.Hands[seat]) != len(cards) {
+			t.Fatalf("Hand of %s is not same hand\nExpected: %v\nActual  : %v", Seat(seat), expected.Hands[seat], actual.Hands[seat])
+		}
+		for _, card := range actual.Hands[seat] {
+			if !cards[card] {
+				t.Fatalf("Hand of %s is not same hand\nExpected: %v\nActual  : %v", Seat(seat), expected.Hands[seat], actual.Hands[seat])
+			}
+		}
+	}
+}
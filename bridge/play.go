@@ -0,0 +1,135 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+
+	gocard "github.com/x-color/gocard"
+	"github.com/x-color/gocard/trick"
+)
+
+// rules is rules of trick engine for the play of a contract.
+type rules struct {
+	contract Contract
+}
+
+func (rules) Players() (players int) {
+	return 4
+}
+
+func (rules) HandSize() (size int) {
+	return 13
+}
+
+func (rules) NewDeck() (deck gocard.Deck) {
+	return gocard.NewDeck()
+}
+
+func (r rules) TrickRule(game *trick.Game) (rule gocard.TrickRule) {
+	return gocard.TrickRule{Trump: r.contract.Strain.Suit()}
+}
+
+func (r rules) Leader(game *trick.Game) (seat int) {
+	return int(r.contract.Declarer.Next())
+}
+
+func (rules) CanPlay(game *trick.Game, seat int, card gocard.Card) (err error) {
+	return err
+}
+
+func (rules) Score(game *trick.Game) (scores []int) {
+	for seat := 0; seat < 4; seat++ {
+		scores = append(scores, game.Taken(seat))
+	}
+	return scores
+}
+
+// Play is the play of a contract. Declarer plays cards of both declarer and dummy.
+type Play struct {
+	Contract Contract
+	game     *trick.Game
+}
+
+// NewPlay returns new play of contract with hands of each seat, and returns error of invalid hands.
+func NewPlay(contract Contract, hands [4]gocard.Cards) (play *Play, err error) {
+	if contract.PassedOut() {
+		err = errors.New("couldn't play, deal is passed out")
+		return play, err
+	}
+	seen := map[gocard.Card]bool{}
+	dealt := make([]gocard.Cards, 4)
+	for seat, hand := range hands {
+		if len(hand) != len(hands[0]) {
+			err = errors.New("couldn't play, hands have different number of cards")
+			return play, err
+		}
+		for _, card := range hand {
+			if seen[card] {
+				err = fmt.Errorf("couldn't play, %s is dealt twice", card)
+				return play, err
+			}
+			seen[card] = true
+		}
+		dealt[seat] = append(gocard.Cards{}, hand...)
+	}
+	play = &Play{Contract: contract, game: trick.NewGame(rules{contract: contract}, int(contract.Declarer))}
+	err = play.game.Start(dealt)
+	return play, err
+}
+
+// Dummy returns seat of dummy.
+func (play *Play) Dummy() (seat Seat) {
+	return play.Contract.Declarer.Partner()
+}
+
+// DummyExposed returns whether hand of dummy is exposed, it is exposed after the opening lead.
+func (play *Play) DummyExposed() (exposed bool) {
+	return len(play.game.Tricks) > 0 || len(play.game.Current.Cards) > 0
+}
+
+// Turn returns seat of the hand to play next.
+func (play *Play) Turn() (seat Seat) {
+	return Seat(play.game.Turn())
+}
+
+// Player returns seat of the player who plays next. It is declarer when dummy's turn.
+func (play *Play) Player() (seat Seat) {
+	if play.Turn() == play.Dummy() {
+		return play.Contract.Declarer
+	}
+	return play.Turn()
+}
+
+// Hand returns remaining cards of seat.
+func (play *Play) Hand(seat Seat) (cards gocard.Cards) {
+	return play.game.Hands[seat]
+}
+
+// Legal returns cards which can be played now.
+func (play *Play) Legal() (cards gocard.Cards) {
+	return play.game.Legal(play.game.Turn())
+}
+
+// Play plays card by the player at seat by, and returns error of illegal play.
+// Declarer plays cards from dummy's hand.
+func (play *Play) Play(by Seat, card gocard.Card) (err error) {
+	if by != play.Player() {
+		return fmt.Errorf("couldn't play, %s must play next", play.Player())
+	}
+	return play.game.Play(play.game.Turn(), card)
+}
+
+// Tricks returns history of completed tricks.
+func (play *Play) Tricks() (tricks []trick.Trick) {
+	return play.game.Tricks
+}
+
+// Done returns whether all tricks are played.
+func (play *Play) Done() (done bool) {
+	return play.game.Done()
+}
+
+// DeclarerTricks returns number of tricks taken by declarer and dummy.
+func (play *Play) DeclarerTricks() (tricks int) {
+	return play.game.Taken(int(play.Contract.Declarer)) + play.game.Taken(int(play.Dummy()))
+}
@@ -0,0 +1,80 @@
+package bridge
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupHands() (hands [4]gocard.Cards) {
+	for i, card := range gocard.NewDeck() {
+		hands[i%4] = append(hands[i%4], card)
+	}
+	return hands
+}
+
+// #################################
+// Test Play.Play()
+// #################################
+
+func TestPlayDeclarerPlaysDummy(t *testing.T) {
+	play, err := NewPlay(Contract{Level: 1, Strain: NOTRUMP, Declarer: SOUTH}, setupHands())
+	if err != nil {
+		t.Fatalf("Couldn't start play\nError: %v", err)
+	}
+	if play.Turn() != WEST || play.DummyExposed() {
+		expected := WEST
+		actual := play.Turn()
+		msg := "Expected left of declarer leads and dummy is not exposed, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	play.Play(WEST, play.Legal()[0])
+
+	if err := play.Play(NORTH, play.Legal()[0]); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as dummy plays own card"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := play.Play(SOUTH, play.Legal()[0]); err != nil || !play.DummyExposed() {
+		expected := error(nil)
+		actual := err
+		msg := "Couldn't play card of dummy by declarer"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestPlayAllTricks(t *testing.T) {
+	play, _ := NewPlay(Contract{Level: 4, Strain: HEARTS, Declarer: EAST}, setupHands())
+	for !play.Done() {
+		if err := play.Play(play.Player(), play.Legal()[0]); err != nil {
+			t.Fatalf("Couldn't play legal card\nError: %v", err)
+		}
+	}
+
+	defenders := 0
+	for _, trick := range play.Tricks() {
+		if Seat(trick.Winner).Side() == NS {
+			defenders++
+		}
+	}
+	if len(play.Tricks()) != 13 || play.DeclarerTricks()+defenders != 13 {
+		expected := 13
+		actual := play.DeclarerTricks() + defenders
+		msg := "Expected 13 tricks are played, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestNewPlayInvalidHands(t *testing.T) {
+	hands := setupHands()
+	hands[0][0] = hands[1][0]
+
+	if _, err := NewPlay(Contract{Level: 1, Strain: CLUBS}, hands); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as starting play with same cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
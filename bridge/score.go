@@ -0,0 +1,148 @@
+package bridge
+
+// trickPoints returns points of contracted tricks of contract.
+func trickPoints(contract Contract) (points int) {
+	switch contract.Strain {
+	case CLUBS, DIAMONDS:
+		points = 20 * contract.Level
+	case HEARTS, SPADES:
+		points = 30 * contract.Level
+	case NOTRUMP:
+		points = 40 + 30*(contract.Level-1)
+	}
+	return points << uint(contract.Risk)
+}
+
+// overtrickPoints returns points of overtricks of contract.
+func overtrickPoints(contract Contract, overtricks int, vulnerable bool) (points int) {
+	switch {
+	case contract.Risk == UNDOUBLED && (contract.Strain == CLUBS || contract.Strain == DIAMONDS):
+		return 20 * overtricks
+	case contract.Risk == UNDOUBLED:
+		return 30 * overtricks
+	case vulnerable:
+		return 100 * overtricks << uint(contract.Risk)
+	default:
+		return 50 * overtricks << uint(contract.Risk)
+	}
+}
+
+// slamBonus returns bonus of small slam or grand slam.
+func slamBonus(contract Contract, vulnerable bool) (bonus int) {
+	switch {
+	case contract.Level == 6 && vulnerable:
+		return 750
+	case contract.Level == 6:
+		return 500
+	case contract.Level == 7 && vulnerable:
+		return 1500
+	case contract.Level == 7:
+		return 1000
+	default:
+		return 0
+	}
+}
+
+// Penalty returns penalty of contract defeated by undertricks.
+func Penalty(contract Contract, undertricks int, vulnerable bool) (penalty int) {
+	if contract.Risk == UNDOUBLED {
+		if vulnerable {
+			return 100 * undertricks
+		}
+		return 50 * undertricks
+	}
+	for i := 1; i <= undertricks; i++ {
+		switch {
+		case i == 1 && vulnerable:
+			penalty += 200
+		case i == 1:
+			penalty += 100
+		case vulnerable || i >= 4:
+			penalty += 300
+		default:
+			penalty += 200
+		}
+	}
+	return penalty << uint(contract.Risk-DOUBLED)
+}
+
+// Score returns duplicate score of contract for declarer's side with tricks taken by declarer.
+// It returns negative score if the contract is defeated.
+func Score(contract Contract, tricks int, vulnerable bool) (score int) {
+	if contract.PassedOut() {
+		return 0
+	}
+	overtricks := tricks - contract.Level - 6
+	if overtricks < 0 {
+		return -Penalty(contract, -overtricks, vulnerable)
+	}
+	points := trickPoints(contract)
+	score = points + overtrickPoints(contract, overtricks, vulnerable) + slamBonus(contract, vulnerable)
+	score += 50 * int(contract.Risk)
+	switch {
+	case points >= 100 && vulnerable:
+		score += 500
+	case points >= 100:
+		score += 300
+	default:
+		score += 50
+	}
+	return score
+}
+
+// Rubber is a score sheet of rubber bridge.
+// Below is trick points of contracts made, and Above is all other points.
+// Game is trick points of current game, and Games is number of games won by each side.
+type Rubber struct {
+	Above [2]int
+	Below [2]int
+	Game  [2]int
+	Games [2]int
+}
+
+// Vulnerable returns whether side is vulnerable, side is vulnerable after winning a game.
+func (rubber *Rubber) Vulnerable(side Side) (vulnerable bool) {
+	return rubber.Games[side] > 0
+}
+
+// Done returns whether the rubber is finished, it is finished when a side wins two games.
+func (rubber *Rubber) Done() (done bool) {
+	return rubber.Games[NS] == 2 || rubber.Games[EW] == 2
+}
+
+// Record records result of contract with tricks taken by declarer.
+// Rubber bonus (700 for 2-0, 500 for 2-1) is added when the rubber is finished.
+func (rubber *Rubber) Record(contract Contract, tricks int) {
+	if contract.PassedOut() || rubber.Done() {
+		return
+	}
+	side := contract.Declarer.Side()
+	vulnerable := rubber.Vulnerable(side)
+	overtricks := tricks - contract.Level - 6
+	if overtricks < 0 {
+		rubber.Above[1-side] += Penalty(contract, -overtricks, vulnerable)
+		return
+	}
+	points := trickPoints(contract)
+	rubber.Below[side] += points
+	rubber.Game[side] += points
+	rubber.Above[side] += overtrickPoints(contract, overtricks, vulnerable) + slamBonus(contract, vulnerable)
+	rubber.Above[side] += 50 * int(contract.Risk)
+	if rubber.Game[side] < 100 {
+		return
+	}
+	rubber.Games[side]++
+	rubber.Game = [2]int{}
+	if rubber.Done() {
+		if rubber.Games[1-side] == 0 {
+			rubber.Above[side] += 700
+		} else {
+			rubber.Above[side] += 500
+		}
+	}
+}
+
+// Total returns total points of side.
+func (rubber *Rubber) Total(side Side) (total int) {
+	return rubber.Above[side] + rubber.Below[side]
+}
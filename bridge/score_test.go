@@ -0,0 +1,75 @@
+package bridge
+
+import (
+	"fmt"
+	"testing"
+)
+
+// #################################
+// Test Score()
+// #################################
+
+func TestScore(t *testing.T) {
+	type result struct {
+		contract   Contract
+		tricks     int
+		vulnerable bool
+	}
+	testCases := map[result]int{
+		{Contract{Level: 4, Strain: SPADES}, 10, false}:                420,
+		{Contract{Level: 3, Strain: NOTRUMP}, 10, true}:                630,
+		{Contract{Level: 2, Strain: CLUBS}, 8, false}:                  90,
+		{Contract{Level: 1, Strain: NOTRUMP, Risk: DOUBLED}, 7, false}: 180,
+		{Contract{Level: 2, Strain: HEARTS, Risk: REDOUBLED}, 9, true}: 1240,
+		{Contract{Level: 6, Strain: DIAMONDS}, 12, false}:              920,
+		{Contract{Level: 7, Strain: NOTRUMP}, 13, true}:                2220,
+		{Contract{Level: 4, Strain: HEARTS}, 8, true}:                  -200,
+		{Contract{Level: 4, Strain: HEARTS, Risk: DOUBLED}, 6, false}:  -800,
+		{Contract{Level: 3, Strain: SPADES, Risk: DOUBLED}, 6, true}:   -800,
+		{Contract{Level: 5, Strain: CLUBS, Risk: REDOUBLED}, 9, false}: -600,
+		{Contract{}, 0, false}:                                         0,
+	}
+	for r, expected := range testCases {
+		actual := Score(r.contract, r.tricks, r.vulnerable)
+		if actual != expected {
+			msg := fmt.Sprintf("Score of %s making %d tricks is not expected score", r.contract, r.tricks)
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test Rubber.Record()
+// #################################
+
+func TestRubber(t *testing.T) {
+	rubber := Rubber{}
+	rubber.Record(Contract{Level: 2, Strain: HEARTS, Declarer: NORTH}, 8)
+	rubber.Record(Contract{Level: 4, Strain: SPADES, Declarer: EAST}, 10)
+	if !rubber.Vulnerable(EW) || rubber.Vulnerable(NS) || rubber.Game[NS] != 0 {
+		expected := "EW is vulnerable and partscore of NS is cleared"
+		actual := fmt.Sprintf("%+v", rubber)
+		msg := "Game is not recorded"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+
+	rubber.Record(Contract{Level: 3, Strain: NOTRUMP, Declarer: WEST}, 9)
+	if !rubber.Done() || rubber.Total(EW) != 120+100+700 || rubber.Total(NS) != 60 {
+		expected := []int{60, 920}
+		actual := []int{rubber.Total(NS), rubber.Total(EW)}
+		msg := "Total of rubber is not expected total"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestRubberPenalty(t *testing.T) {
+	rubber := Rubber{Games: [2]int{1, 0}}
+	rubber.Record(Contract{Level: 4, Strain: SPADES, Risk: DOUBLED, Declarer: SOUTH}, 8)
+
+	if rubber.Above[EW] != 500 {
+		expected := 500
+		actual := rubber.Above[EW]
+		msg := "Penalty is not recorded above the line of defenders"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -125,6 +125,66 @@ func (cards Cards) SortByRank() {
 	sort.Sort(ByRank{cards})
 }
 
+// IndexOf returns index of the first card in cards which is same as card, and -1 if cards don't have it.
+func (cards Cards) IndexOf(card Card) (index int) {
+	for i, c := range cards {
+		if c == card {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains returns whether cards have card.
+func (cards Cards) Contains(card Card) (ok bool) {
+	return cards.IndexOf(card) >= 0
+}
+
+// Count returns number of cards which satisfy f.
+func (cards Cards) Count(f func(card Card) bool) (count int) {
+	for _, card := range cards {
+		if f(card) {
+			count++
+		}
+	}
+	return count
+}
+
+// Filter returns new cards which satisfy f in same order.
+func (cards Cards) Filter(f func(card Card) bool) (filtered Cards) {
+	for _, card := range cards {
+		if f(card) {
+			filtered = append(filtered, card)
+		}
+	}
+	return filtered
+}
+
+// Remove removes the first card which is same as card, and returns error if cards don't have it.
+func (cards *Cards) Remove(card Card) (err error) {
+	index := cards.IndexOf(card)
+	if index < 0 {
+		err = fmt.Errorf("couldn't remove, %s is not in cards", card)
+		return err
+	}
+	*cards = append((*cards)[:index:index], (*cards)[index+1:]...)
+	return err
+}
+
+// RemoveAll removes all cards which satisfy f and returns removed cards.
+func (cards *Cards) RemoveAll(f func(card Card) bool) (removed Cards) {
+	var kept Cards
+	for _, card := range *cards {
+		if f(card) {
+			removed = append(removed, card)
+		} else {
+			kept = append(kept, card)
+		}
+	}
+	*cards = kept
+	return removed
+}
+
 // These constant values are suits of card.
 const (
 	SPADES Suit = iota + 1
@@ -366,3 +366,80 @@ func TestCompareByRankSameCards(t *testing.T) {
 		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
 	}
 }
+
+// #################################
+// Test Cards.IndexOf(), Cards.Contains()
+// #################################
+
+func TestIndexOf(t *testing.T) {
+	cards := Cards{{Rank: ACE, Suit: SPADES}, {Rank: KING, Suit: HEARTS}, {Rank: KING, Suit: HEARTS}}
+
+	if index := cards.IndexOf(Card{Rank: KING, Suit: HEARTS}); index != 1 {
+		expected := 1
+		actual := index
+		msg := "Expected index of the first same card, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if cards.Contains(Card{Rank: TWO, Suit: CLUBS}) {
+		expected := false
+		actual := true
+		msg := "Expected cards don't contain Two of Clubs, but contain"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Cards.Count(), Cards.Filter()
+// #################################
+
+func TestCountAndFilter(t *testing.T) {
+	cards := Cards{{Rank: ACE, Suit: SPADES}, {Rank: KING, Suit: HEARTS}, {Rank: TWO, Suit: HEARTS}}
+	hearts := func(card Card) bool { return card.Suit == HEARTS }
+
+	if count := cards.Count(hearts); count != 2 {
+		expected := 2
+		actual := count
+		msg := "Number of Hearts is not expected number"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if filtered := cards.Filter(hearts); len(filtered) != 2 || filtered[0].Rank != KING || len(cards) != 3 {
+		expected := Cards{{Rank: KING, Suit: HEARTS}, {Rank: TWO, Suit: HEARTS}}
+		actual := filtered
+		msg := "Filtered cards are not expected cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Cards.Remove(), Cards.RemoveAll()
+// #################################
+
+func TestRemove(t *testing.T) {
+	cards := Cards{{Rank: ACE, Suit: SPADES}, {Rank: KING, Suit: HEARTS}}
+	original := cards
+
+	if err := cards.Remove(Card{Rank: ACE, Suit: SPADES}); err != nil || len(cards) != 1 || original[0].Rank != ACE {
+		expected := Cards{{Rank: KING, Suit: HEARTS}}
+		actual := cards
+		msg := "Expected card is removed without changing original slice, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := cards.Remove(Card{Rank: ACE, Suit: SPADES}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as removing card which is not in cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestRemoveAll(t *testing.T) {
+	cards := Cards{{Rank: ACE, Suit: SPADES}, {Rank: KING, Suit: HEARTS}, {Rank: TWO, Suit: HEARTS}}
+	removed := cards.RemoveAll(func(card Card) bool { return card.Suit == HEARTS })
+
+	if len(removed) != 2 || len(cards) != 1 || cards[0].Rank != ACE {
+		expected := Cards{{Rank: ACE, Suit: SPADES}}
+		actual := cards
+		msg := "Expected all Hearts are removed, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Package cardtest implements generators of random valid cards and decks for property-based tests,
+and checkers of invariants such as card conservation.
+
+Card, Cards and Deck implement quick.Generator, so they can be arguments of functions checked by testing/quick.
+
+	f := func(deck cardtest.Deck) bool {
+		shuffled := append(gocard.Deck{}, deck...)
+		shuffled.Shuffle()
+		return cardtest.Conserved(gocard.Cards(deck), gocard.Cards(shuffled)) == nil
+	}
+	err := quick.Check(f, nil)
+
+CardOf and CardsOf convert fuzz inputs to valid cards.
+*/
+package cardtest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Card is a random valid card.
+type Card gocard.Card
+
+// Cards is random valid cards without duplicates.
+type Cards gocard.Cards
+
+// Deck is a random partial deck of 0 ~ 52 cards without duplicates in random order.
+type Deck gocard.Deck
+
+// Generate returns random valid card for testing/quick.
+func (Card) Generate(r *rand.Rand, size int) (value reflect.Value) {
+	return reflect.ValueOf(Card(CardOf(r.Intn(52))))
+}
+
+// Generate returns random valid cards without duplicates for testing/quick. Number of cards is up to size and 52.
+func (Cards) Generate(r *rand.Rand, size int) (value reflect.Value) {
+	if size > 52 {
+		size = 52
+	}
+	return reflect.ValueOf(Cards(sample(r, r.Intn(size+1))))
+}
+
+// Generate returns random partial deck for testing/quick.
+func (Deck) Generate(r *rand.Rand, size int) (value reflect.Value) {
+	return reflect.ValueOf(Deck(sample(r, r.Intn(53))))
+}
+
+// sample returns n cards chosen from a deck of 52 cards at random.
+func sample(r *rand.Rand, n int) (cards gocard.Cards) {
+	deck := gocard.NewDeck()
+	deck.ShuffleWith(r)
+	return append(gocard.Cards{}, deck[:n]...)
+}
+
+// CardOf returns valid card of any integer. Integers are mapped to 52 cards in order of new deck.
+func CardOf(n int) (card gocard.Card) {
+	n %= 52
+	if n < 0 {
+		n += 52
+	}
+	return gocard.Card{Rank: gocard.Rank(n%13 + 1), Suit: gocard.Suit(n/13 + 1)}
+}
+
+// CardsOf returns valid cards without duplicates of bytes for fuzz tests. Bytes mapped to same card are skipped.
+func CardsOf(data []byte) (cards gocard.Cards) {
+	seen := map[gocard.Card]bool{}
+	for _, b := range data {
+		card := CardOf(int(b))
+		if !seen[card] {
+			seen[card] = true
+			cards = append(cards, card)
+		}
+	}
+	return cards
+}
+
+// Unique returns error if cards have duplicates or invalid cards.
+func Unique(cards gocard.Cards) (err error) {
+	seen := map[gocard.Card]bool{}
+	for _, card := range cards {
+		if card.Rank < gocard.ACE || card.Rank > gocard.KING || card.Suit < gocard.SPADES || card.Suit > gocard.CLUBS {
+			err = fmt.Errorf("invalid card %v", card)
+			return err
+		}
+		if seen[card] {
+			err = fmt.Errorf("duplicated card %s", card)
+			return err
+		}
+		seen[card] = true
+	}
+	return err
+}
+
+// Conserved returns error if cards in piles are not same as cards of before regardless of order.
+// The error reports lost and created cards.
+func Conserved(before gocard.Cards, piles ...gocard.Cards) (err error) {
+	count := map[gocard.Card]int{}
+	for _, card := range before {
+		count[card]++
+	}
+	for _, pile := range piles {
+		for _, card := range pile {
+			count[card]--
+		}
+	}
+
+	var lost, created gocard.Cards
+	for _, card := range gocard.NewDeck() {
+		for ; count[card] > 0; count[card]-- {
+			lost = append(lost, card)
+		}
+		for ; count[card] < 0; count[card]++ {
+			created = append(created, card)
+		}
+		delete(count, card)
+	}
+	for card, n := range count {
+		if n > 0 {
+			lost = append(lost, card)
+		} else if n < 0 {
+			created = append(created, card)
+		}
+	}
+	if len(lost) > 0 || len(created) > 0 {
+		err = fmt.Errorf("cards are not conserved, lost %v, created %v", lost, created)
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package cardtest
+
+import (
+	"testing"
+	"testing/quick"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test generators
+// #################################
+
+func TestGenerators(t *testing.T) {
+	f := func(card Card, cards Cards, deck Deck) bool {
+		return Unique(gocard.Cards{gocard.Card(card)}) == nil &&
+			Unique(gocard.Cards(cards)) == nil &&
+			Unique(gocard.Cards(deck)) == nil
+	}
+	if err := quick.Check(f, nil); err != nil {
+		expected := "valid cards without duplicates"
+		actual := err
+		msg := "Generated values are not valid"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Conserved()
+// #################################
+
+func TestConservedDeckOperations(t *testing.T) {
+	f := func(deck Deck, card Card) bool {
+		before := gocard.Cards(deck)
+		d := gocard.Deck(append(gocard.Cards{}, before...))
+		d.Shuffle()
+		drawn, err := d.Draw()
+		if err != nil {
+			return len(before) == 0
+		}
+		d.PutBottom(drawn)
+		d.PutTop(gocard.Card(card))
+		return Conserved(append(before, gocard.Card(card)), gocard.Cards(d)) == nil
+	}
+	if err := quick.Check(f, nil); err != nil {
+		expected := "cards are conserved"
+		actual := err
+		msg := "Expected Draw, PutTop and Shuffle conserve cards, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestConservedLostAndCreated(t *testing.T) {
+	before, _ := gocard.ParseCards("As Kd 6h")
+	hand, _ := gocard.ParseCards("As 6h")
+	pile, _ := gocard.ParseCards("6h")
+
+	if err := Conserved(before, hand, pile); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as losing Kd and duplicating 6h"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := Conserved(before, hand, gocard.Cards{before[1]}); err != nil {
+		expected := error(nil)
+		actual := err
+		msg := "Expected cards split into piles are conserved, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test CardsOf()
+// #################################
+
+func FuzzCardsOf(f *testing.F) {
+	f.Add([]byte{0, 52, 1, 255})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := Unique(CardsOf(data)); err != nil {
+			t.Fatalf("Cards of fuzz input are not valid\nError: %v", err)
+		}
+	})
+}
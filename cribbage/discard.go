@@ -0,0 +1,97 @@
+package cribbage
+
+import (
+	"errors"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Discard is a choice of 2 cards discarded to the crib from 6-card hand.
+// Hand is expected points of kept cards, and Crib is expected points of the crib.
+type Discard struct {
+	Cards gocard.Cards
+	Keep  gocard.Cards
+	Hand  float64
+	Crib  float64
+}
+
+// Expected returns expected points of discard for the player.
+// Points of the crib are added for the dealer and subtracted for the other player.
+func (discard Discard) Expected(dealer bool) (points float64) {
+	if dealer {
+		return discard.Hand + discard.Crib
+	}
+	return discard.Hand - discard.Crib
+}
+
+// Discards returns all 15 choices of discards from 6-card hand with expected points,
+// and returns error if hand is invalid.
+// Expected points are averages over every starter and cards discarded by the opponent
+// from the remaining deck.
+func Discards(hand gocard.Cards) (discards []Discard, err error) {
+	if len(hand) != 6 {
+		err = errors.New("couldn't discard, hand must have 6 cards")
+		return discards, err
+	}
+	var remaining gocard.Cards
+	for _, card := range gocard.NewDeck() {
+		in := false
+		for _, c := range hand {
+			in = in || c == card
+		}
+		if !in {
+			remaining = append(remaining, card)
+		}
+	}
+	if len(remaining) != 46 {
+		err = errors.New("couldn't discard, hand has same cards")
+		return discards, err
+	}
+
+	for i := 0; i < len(hand); i++ {
+		for j := i + 1; j < len(hand); j++ {
+			discard := Discard{Cards: gocard.Cards{hand[i], hand[j]}}
+			for k, card := range hand {
+				if k != i && k != j {
+					discard.Keep = append(discard.Keep, card)
+				}
+			}
+			keep := [5]gocard.Card{discard.Keep[0], discard.Keep[1], discard.Keep[2], discard.Keep[3]}
+			crib := [5]gocard.Card{hand[i], hand[j]}
+			var hands, cribs, n int
+			for s, starter := range remaining {
+				keep[4], crib[4] = starter, starter
+				hands += score5(keep, false).Total()
+				for a := range remaining {
+					for b := a + 1; b < len(remaining); b++ {
+						if a == s || b == s {
+							continue
+						}
+						crib[2], crib[3] = remaining[a], remaining[b]
+						cribs += score5(crib, true).Total()
+						n++
+					}
+				}
+			}
+			discard.Hand = float64(hands) / float64(len(remaining))
+			discard.Crib = float64(cribs) / float64(n)
+			discards = append(discards, discard)
+		}
+	}
+	return discards, err
+}
+
+// BestDiscard returns discard which maximizes expected points for the player, and returns error if hand is invalid.
+func BestDiscard(hand gocard.Cards, dealer bool) (best Discard, err error) {
+	discards, err := Discards(hand)
+	if err != nil {
+		return best, err
+	}
+	best = discards[0]
+	for _, discard := range discards[1:] {
+		if discard.Expected(dealer) > best.Expected(dealer) {
+			best = discard
+		}
+	}
+	return best, err
+}
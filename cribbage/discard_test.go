@@ -0,0 +1,51 @@
+package cribbage
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test BestDiscard()
+// #################################
+
+func TestBestDiscard(t *testing.T) {
+	hand := gocard.Cards{
+		setupCard(gocard.FIVE, gocard.HEARTS),
+		setupCard(gocard.FIVE, gocard.DIAMONDS),
+		setupCard(gocard.FIVE, gocard.CLUBS),
+		setupCard(gocard.JACK, gocard.SPADES),
+		setupCard(gocard.KING, gocard.HEARTS),
+		setupCard(gocard.TWO, gocard.CLUBS),
+	}
+
+	best, err := BestDiscard(hand, false)
+	if err != nil {
+		t.Fatalf("Couldn't decide discard\nError: %v", err)
+	}
+	expected := gocard.Cards{hand[4], hand[5]}
+	if best.Cards[0] != expected[0] || best.Cards[1] != expected[1] || best.Hand < 14 {
+		actual := best
+		msg := "Expected Five, Five, Five and Jack are kept by the opponent of the dealer, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %+v", msg, expected, actual)
+	}
+}
+
+func TestDiscardsInvalid(t *testing.T) {
+	hand := gocard.Cards(gocard.NewDeck()[:5])
+	if _, err := Discards(hand); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as discarding from 5-card hand"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+
+	hand = append(hand, hand[0])
+	if _, err := Discards(hand); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as discarding from hand which has same cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Package cribbage implements scoring of Cribbage hands, pegging and crib discards.
+*/
+package cribbage
+
+import (
+	"errors"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Score is score of a hand and the starter.
+type Score struct {
+	Fifteens int
+	Pairs    int
+	Runs     int
+	Flush    int
+	Nobs     int
+}
+
+// Total returns total points of score.
+func (score Score) Total() (points int) {
+	return score.Fifteens + score.Pairs + score.Runs + score.Flush + score.Nobs
+}
+
+// Value returns counting value of card. (Ace: 1, Two ~ Ten: 2 ~ 10, Jack ~ King: 10)
+func Value(card gocard.Card) (value int) {
+	if card.Rank >= gocard.TEN {
+		return 10
+	}
+	return int(card.Rank)
+}
+
+// Heels returns points of the dealer for the starter. (2 points for Jack)
+func Heels(starter gocard.Card) (points int) {
+	if starter.Rank == gocard.JACK {
+		return 2
+	}
+	return 0
+}
+
+// ScoreHand returns score of 4-card hand and the starter, and returns error if hand is invalid.
+// If crib is true, flush is scored only if all 5 cards are same suit.
+func ScoreHand(hand gocard.Cards, starter gocard.Card, crib bool) (score Score, err error) {
+	if len(hand) != 4 {
+		err = errors.New("couldn't score, hand must have 4 cards")
+		return score, err
+	}
+	cards := [5]gocard.Card{hand[0], hand[1], hand[2], hand[3], starter}
+	for i := range cards {
+		for j := i + 1; j < len(cards); j++ {
+			if cards[i] == cards[j] {
+				err = errors.New("couldn't score, hand has same cards")
+				return score, err
+			}
+		}
+	}
+	return score5(cards, crib), err
+}
+
+// score5 returns score of 4 cards in hand and the starter which is the last card.
+func score5(cards [5]gocard.Card, crib bool) (score Score) {
+	for mask := 1; mask < 1<<5; mask++ {
+		sum := 0
+		for i, card := range cards {
+			if mask&(1<<uint(i)) != 0 {
+				sum += Value(card)
+			}
+		}
+		if sum == 15 {
+			score.Fifteens += 2
+		}
+	}
+
+	var counts [gocard.KING + 2]int
+	for i, card := range cards {
+		counts[card.Rank]++
+		for j := i + 1; j < len(cards); j++ {
+			if card.Rank == cards[j].Rank {
+				score.Pairs += 2
+			}
+		}
+	}
+	for low := gocard.ACE; low <= gocard.KING; {
+		high, ways := low, 1
+		for ; counts[high] > 0; high++ {
+			ways *= counts[high]
+		}
+		if length := int(high - low); length >= 3 {
+			score.Runs += length * ways
+		}
+		low = high + 1
+	}
+
+	flush := true
+	for _, card := range cards[1:4] {
+		flush = flush && card.Suit == cards[0].Suit
+	}
+	switch {
+	case flush && cards[4].Suit == cards[0].Suit:
+		score.Flush = 5
+	case flush && !crib:
+		score.Flush = 4
+	}
+
+	for _, card := range cards[:4] {
+		if card.Rank == gocard.JACK && card.Suit == cards[4].Suit {
+			score.Nobs = 1
+		}
+	}
+	return score
+}
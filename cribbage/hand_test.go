@@ -0,0 +1,97 @@
+package cribbage
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupCard(rank gocard.Rank, suit gocard.Suit) (card gocard.Card) {
+	return gocard.Card{Rank: rank, Suit: suit}
+}
+
+// #################################
+// Test ScoreHand()
+// #################################
+
+func TestScoreHandPerfect(t *testing.T) {
+	hand := gocard.Cards{
+		setupCard(gocard.FIVE, gocard.HEARTS),
+		setupCard(gocard.FIVE, gocard.DIAMONDS),
+		setupCard(gocard.FIVE, gocard.CLUBS),
+		setupCard(gocard.JACK, gocard.SPADES),
+	}
+	starter := setupCard(gocard.FIVE, gocard.SPADES)
+
+	score, err := ScoreHand(hand, starter, false)
+	expected := Score{Fifteens: 16, Pairs: 12, Nobs: 1}
+	if err != nil || score != expected || score.Total() != 29 {
+		actual := score
+		msg := "Score of 29 hand is not expected score"
+		t.Fatalf("%s\nExpected: %+v\nActual  : %+v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestScoreHandDoubleRun(t *testing.T) {
+	hand := gocard.Cards{
+		setupCard(gocard.THREE, gocard.HEARTS),
+		setupCard(gocard.FOUR, gocard.HEARTS),
+		setupCard(gocard.FOUR, gocard.SPADES),
+		setupCard(gocard.FIVE, gocard.CLUBS),
+	}
+	starter := setupCard(gocard.KING, gocard.DIAMONDS)
+
+	score, err := ScoreHand(hand, starter, false)
+	expected := Score{Fifteens: 2, Pairs: 2, Runs: 6}
+	if err != nil || score != expected {
+		actual := score
+		msg := "Score of double run is not expected score"
+		t.Fatalf("%s\nExpected: %+v\nActual  : %+v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestScoreHandFlush(t *testing.T) {
+	hand := gocard.Cards{
+		setupCard(gocard.TWO, gocard.CLUBS),
+		setupCard(gocard.SIX, gocard.CLUBS),
+		setupCard(gocard.EIGHT, gocard.CLUBS),
+		setupCard(gocard.QUEEN, gocard.CLUBS),
+	}
+	starter := setupCard(gocard.ACE, gocard.HEARTS)
+
+	if score, _ := ScoreHand(hand, starter, false); score.Flush != 4 {
+		expected := 4
+		actual := score.Flush
+		msg := "Expected 4-card flush in hand scores 4, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if score, _ := ScoreHand(hand, starter, true); score.Flush != 0 {
+		expected := 0
+		actual := score.Flush
+		msg := "Expected 4-card flush in crib scores nothing, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestScoreHandInvalid(t *testing.T) {
+	hand := gocard.Cards{
+		setupCard(gocard.TWO, gocard.CLUBS),
+		setupCard(gocard.SIX, gocard.CLUBS),
+		setupCard(gocard.EIGHT, gocard.CLUBS),
+		setupCard(gocard.QUEEN, gocard.CLUBS),
+	}
+
+	if _, err := ScoreHand(hand, hand[0], false); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as scoring hand which has same cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if _, err := ScoreHand(hand[:3], hand[3], false); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as scoring 3-card hand"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
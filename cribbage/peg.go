@@ -0,0 +1,95 @@
+package cribbage
+
+import (
+	"errors"
+	"fmt"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Pegging is a sequence of cards played in the play of Cribbage.
+// Count is total value of cards in the sequence, and it is never more than 31.
+type Pegging struct {
+	Cards gocard.Cards
+	Count int
+}
+
+// CanPlay returns whether card can be played without going over 31.
+func (pegging *Pegging) CanPlay(card gocard.Card) (ok bool) {
+	return pegging.Count+Value(card) <= 31
+}
+
+// Play plays card to the sequence and returns points pegged by it, and returns error if count goes over 31.
+// It scores 15 and 31 (2 points), pairs (2, 6 or 12 points) and runs of the last cards (1 point per card).
+// The sequence starts over after the count reaches 31.
+func (pegging *Pegging) Play(card gocard.Card) (points int, err error) {
+	if !pegging.CanPlay(card) {
+		err = fmt.Errorf("couldn't play %s, count goes over 31", card)
+		return points, err
+	}
+	pegging.Cards = append(pegging.Cards, card)
+	pegging.Count += Value(card)
+
+	if pegging.Count == 15 || pegging.Count == 31 {
+		points += 2
+	}
+	points += pegging.pairs() + pegging.run()
+
+	if pegging.Count == 31 {
+		pegging.reset()
+	}
+	return points, err
+}
+
+// Go ends the sequence because no one can play, and returns 1 point for the last card.
+// It returns error if the sequence is empty.
+func (pegging *Pegging) Go() (points int, err error) {
+	if len(pegging.Cards) == 0 {
+		err = errors.New("couldn't go, no cards are played")
+		return points, err
+	}
+	pegging.reset()
+	return 1, err
+}
+
+// reset starts a new sequence.
+func (pegging *Pegging) reset() {
+	pegging.Cards = nil
+	pegging.Count = 0
+}
+
+// pairs returns points of cards of same rank as the last card played in a row.
+func (pegging *Pegging) pairs() (points int) {
+	last := len(pegging.Cards) - 1
+	n := 1
+	for i := last - 1; i >= 0 && pegging.Cards[i].Rank == pegging.Cards[last].Rank; i-- {
+		n++
+	}
+	return n * (n - 1)
+}
+
+// run returns points of the longest run made of the last cards played in any order.
+func (pegging *Pegging) run() (points int) {
+	for n := len(pegging.Cards); n >= 3; n-- {
+		var seen [gocard.KING + 1]bool
+		low, high := gocard.KING, gocard.ACE
+		run := true
+		for _, card := range pegging.Cards[len(pegging.Cards)-n:] {
+			if seen[card.Rank] {
+				run = false
+				break
+			}
+			seen[card.Rank] = true
+			if card.Rank < low {
+				low = card.Rank
+			}
+			if card.Rank > high {
+				high = card.Rank
+			}
+		}
+		if run && int(high-low)+1 == n {
+			return n
+		}
+	}
+	return 0
+}
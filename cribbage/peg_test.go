@@ -0,0 +1,99 @@
+package cribbage
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test Pegging.Play()
+// #################################
+
+func TestPlay(t *testing.T) {
+	pegging := Pegging{}
+	plays := []struct {
+		card   gocard.Card
+		points int
+	}{
+		{setupCard(gocard.SEVEN, gocard.HEARTS), 0},
+		{setupCard(gocard.EIGHT, gocard.CLUBS), 2},  // 15
+		{setupCard(gocard.SIX, gocard.SPADES), 3},   // run of 6, 7, 8
+		{setupCard(gocard.SIX, gocard.DIAMONDS), 2}, // pair
+		{setupCard(gocard.THREE, gocard.DIAMONDS), 0},
+	}
+	for i, play := range plays {
+		if points, err := pegging.Play(play.card); err != nil || points != play.points {
+			expected := play.points
+			actual := points
+			msg := "Points pegged by card are not expected points"
+			t.Fatalf("%s (play %d)\nExpected: %v\nActual  : %v (%v)", msg, i, expected, actual, err)
+		}
+	}
+
+	if _, err := pegging.Play(setupCard(gocard.KING, gocard.SPADES)); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as going over 31"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestPlayThirtyOne(t *testing.T) {
+	pegging := Pegging{}
+	cards := gocard.Cards{
+		setupCard(gocard.KING, gocard.HEARTS),
+		setupCard(gocard.QUEEN, gocard.HEARTS),
+		setupCard(gocard.EIGHT, gocard.HEARTS),
+	}
+	for _, card := range cards {
+		pegging.Play(card)
+	}
+
+	if points, err := pegging.Play(setupCard(gocard.THREE, gocard.CLUBS)); err != nil || points != 2 || pegging.Count != 0 {
+		expected := 2
+		actual := points
+		msg := "Expected 31 scores 2 and starts a new sequence, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (count %d, %v)", msg, expected, actual, pegging.Count, err)
+	}
+}
+
+func TestPlayRunOutOfOrder(t *testing.T) {
+	pegging := Pegging{}
+	cards := gocard.Cards{
+		setupCard(gocard.FOUR, gocard.HEARTS),
+		setupCard(gocard.TWO, gocard.SPADES),
+	}
+	for _, card := range cards {
+		pegging.Play(card)
+	}
+
+	if points, _ := pegging.Play(setupCard(gocard.THREE, gocard.CLUBS)); points != 3 {
+		expected := 3
+		actual := points
+		msg := "Expected 4, 2, 3 is a run of 3 cards, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Pegging.Go()
+// #################################
+
+func TestGo(t *testing.T) {
+	pegging := Pegging{}
+	if _, err := pegging.Go(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as going without played cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+
+	pegging.Play(setupCard(gocard.KING, gocard.HEARTS))
+	if points, err := pegging.Go(); err != nil || points != 1 || len(pegging.Cards) != 0 {
+		expected := 1
+		actual := points
+		msg := "Expected go scores 1 for the last card and starts a new sequence, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
@@ -2,6 +2,7 @@ package card
 
 import (
 	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 )
@@ -21,6 +22,14 @@ func (deck Deck) Shuffle() {
 	}
 }
 
+// ShuffleWith shuffles the deck with random source r. Same source shuffles the deck in same order.
+func (deck Deck) ShuffleWith(r *rand.Rand) {
+	for i := len(deck); i > 0; i-- {
+		randIndex := r.Intn(i)
+		deck[i-1], deck[randIndex] = deck[randIndex], deck[i-1]
+	}
+}
+
 // Draw draws card from the top of the deck and returns drawn card, error of empty deck.
 func (deck *Deck) Draw() (card Card, err error) {
 	if len(*deck) == 0 {
@@ -41,6 +50,143 @@ func (deck *Deck) PutBottom(card Card) {
 	*deck = append(*deck, card)
 }
 
+// IndexOf returns index of the first card in the deck which is same as card, and -1 if the deck doesn't have it.
+func (deck Deck) IndexOf(card Card) (index int) {
+	return Cards(deck).IndexOf(card)
+}
+
+// Contains returns whether the deck has card.
+func (deck Deck) Contains(card Card) (ok bool) {
+	return Cards(deck).Contains(card)
+}
+
+// Count returns number of cards in the deck which satisfy f.
+func (deck Deck) Count(f func(card Card) bool) (count int) {
+	return Cards(deck).Count(f)
+}
+
+// Filter returns new deck of cards which satisfy f in same order.
+func (deck Deck) Filter(f func(card Card) bool) (filtered Deck) {
+	return Deck(Cards(deck).Filter(f))
+}
+
+// Remove removes the first card which is same as card from the deck, and returns error if the deck doesn't have it.
+func (deck *Deck) Remove(card Card) (err error) {
+	cards := Cards(*deck)
+	if err = cards.Remove(card); err != nil {
+		err = fmt.Errorf("couldn't remove, %s is not in deck", card)
+		return err
+	}
+	*deck = Deck(cards)
+	return err
+}
+
+// RemoveAll removes all cards which satisfy f from the deck and returns removed cards.
+func (deck *Deck) RemoveAll(f func(card Card) bool) (removed Cards) {
+	cards := Cards(*deck)
+	removed = cards.RemoveAll(f)
+	*deck = Deck(cards)
+	return removed
+}
+
+// PositionError is an error of position which is out of the deck.
+type PositionError struct {
+	Op       string
+	Position int
+	Length   int
+}
+
+// Error returns message of the error. (e.g. couldn't cut, position 53 is out of deck of 52 cards)
+func (err *PositionError) Error() (msg string) {
+	return fmt.Sprintf("couldn't %s, position %d is out of deck of %d cards", err.Op, err.Position, err.Length)
+}
+
+// InsertAt inserts a card at index of the deck. Index 0 is the top, and index len(deck) is the bottom.
+func (deck *Deck) InsertAt(index int, card Card) (err error) {
+	if index < 0 || index > len(*deck) {
+		err = &PositionError{Op: "insert", Position: index, Length: len(*deck)}
+		return err
+	}
+	*deck = append(*deck, Card{})
+	copy((*deck)[index+1:], (*deck)[index:])
+	(*deck)[index] = card
+	return err
+}
+
+// Cut moves n cards from the top to the bottom of the deck.
+func (deck Deck) Cut(n int) (err error) {
+	if n < 0 || n > len(deck) {
+		err = &PositionError{Op: "cut", Position: n, Length: len(deck)}
+		return err
+	}
+	deck.Rotate(n)
+	return err
+}
+
+// Rotate moves n cards from the top to the bottom of the deck.
+// If n is negative, it moves -n cards from the bottom to the top. n larger than the deck wraps around.
+func (deck Deck) Rotate(n int) {
+	if len(deck) == 0 {
+		return
+	}
+	n %= len(deck)
+	if n < 0 {
+		n += len(deck)
+	}
+	deck[:n].Reverse()
+	deck[n:].Reverse()
+	deck.Reverse()
+}
+
+// Reverse reverses order of the deck.
+func (deck Deck) Reverse() {
+	for i, j := 0, len(deck)-1; i < j; i, j = i+1, j-1 {
+		deck[i], deck[j] = deck[j], deck[i]
+	}
+}
+
+// Split returns copies of cards above index and cards from index of the deck. The deck is not changed.
+func (deck Deck) Split(index int) (top Deck, bottom Deck, err error) {
+	if index < 0 || index > len(deck) {
+		err = &PositionError{Op: "split", Position: index, Length: len(deck)}
+		return top, bottom, err
+	}
+	top = append(Deck{}, deck[:index]...)
+	bottom = append(Deck{}, deck[index:]...)
+	return top, bottom, err
+}
+
+// Merge returns deck which interleaves cards of piles one by one from the top of each pile.
+// Remaining cards of longer piles follow in turn. (e.g. [A B C] [1 2] => [A 1 B 2 C])
+func Merge(piles ...Deck) (deck Deck) {
+	for i := 0; ; i++ {
+		merged := false
+		for _, pile := range piles {
+			if i < len(pile) {
+				deck = append(deck, pile[i])
+				merged = true
+			}
+		}
+		if !merged {
+			return deck
+		}
+	}
+}
+
+// FaroOut does a perfect out-shuffle. The deck is split in half and interleaved so that the top card stays on the top.
+// If the deck has odd number of cards, the top half has one more card.
+func (deck Deck) FaroOut() {
+	top, bottom, _ := deck.Split((len(deck) + 1) / 2)
+	copy(deck, Merge(top, bottom))
+}
+
+// FaroIn does a perfect in-shuffle. The deck is split in half and interleaved so that the top card goes second.
+// If the deck has odd number of cards, the bottom half has one more card.
+func (deck Deck) FaroIn() {
+	top, bottom, _ := deck.Split(len(deck) / 2)
+	copy(deck, Merge(bottom, top))
+}
+
 // NewDeck returns new deck sorted by suits is a set of 52 cards.
 func NewDeck() (deck Deck) {
 	for suitNumber := 1; suitNumber <= 4; suitNumber++ {
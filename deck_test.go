@@ -2,6 +2,7 @@ package card
 
 import (
 	"errors"
+	"math/rand"
 	"testing"
 )
 
@@ -111,3 +112,210 @@ func TestShuffle(t *testing.T) {
 		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
 	}
 }
+
+// #################################
+// Test Deck.ShuffleWith()
+// #################################
+
+func TestShuffleWith(t *testing.T) {
+	deck1, deck2 := NewDeck(), NewDeck()
+	deck1.ShuffleWith(rand.New(rand.NewSource(42)))
+	deck2.ShuffleWith(rand.New(rand.NewSource(42)))
+
+	for i := range deck1 {
+		if deck1[i] != deck2[i] {
+			expected := deck1[i]
+			actual := deck2[i]
+			msg := "Expected decks shuffled with same seed are same, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test Deck.Remove(), Deck.RemoveAll()
+// #################################
+
+func TestRemoveFromDeck(t *testing.T) {
+	deck := NewDeck()
+	card := Card{Rank: ACE, Suit: SPADES}
+
+	if err := deck.Remove(card); err != nil || len(deck) != 51 || deck.Contains(card) {
+		expected := 51
+		actual := len(deck)
+		msg := "Expected Ace of Spades is removed from deck, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := deck.Remove(card); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as removing card which is not in deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if removed := deck.RemoveAll(func(card Card) bool { return card.Rank == ACE }); len(removed) != 3 || len(deck) != 48 {
+		expected := 3
+		actual := len(removed)
+		msg := "Expected remaining 3 Aces are removed from deck, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// Setup for test
+func setupSmallDeck(n int) (deck Deck) {
+	for rank := 1; rank <= n; rank++ {
+		deck = append(deck, Card{Rank: Rank(rank), Suit: SPADES})
+	}
+	return deck
+}
+
+// ranks returns ranks of cards in deck.
+func ranks(deck Deck) (rs []Rank) {
+	for _, card := range deck {
+		rs = append(rs, card.Rank)
+	}
+	return rs
+}
+
+// #################################
+// Test Deck.InsertAt()
+// #################################
+
+func TestInsertAt(t *testing.T) {
+	deck := setupSmallDeck(3)
+	card := Card{Rank: KING, Suit: HEARTS}
+
+	if err := deck.InsertAt(1, card); err != nil || deck[1] != card || len(deck) != 4 {
+		expected := card
+		actual := deck
+		msg := "Expected card is inserted at index 1, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := deck.InsertAt(4, card); err != nil || deck[4] != card {
+		expected := card
+		actual := deck
+		msg := "Expected card is inserted at the bottom, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestInsertAtOutOfDeck(t *testing.T) {
+	deck := setupSmallDeck(3)
+	err := deck.InsertAt(4, Card{Rank: KING, Suit: HEARTS})
+
+	var positionErr *PositionError
+	if !errors.As(err, &positionErr) || positionErr.Position != 4 || len(deck) != 3 {
+		expected := &PositionError{Op: "insert", Position: 4, Length: 3}
+		actual := err
+		msg := "Couldn't catch error as inserting out of deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Deck.Cut(), Deck.Rotate()
+// #################################
+
+func TestCut(t *testing.T) {
+	deck := setupSmallDeck(5)
+
+	if err := deck.Cut(2); err != nil || deck[0].Rank != THREE || deck[4].Rank != TWO {
+		expected := []Rank{THREE, FOUR, FIVE, ACE, TWO}
+		actual := ranks(deck)
+		msg := "Expected 2 cards are moved to the bottom, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := deck.Cut(6); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as cutting more cards than deck has"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestRotateNegative(t *testing.T) {
+	deck := setupSmallDeck(5)
+
+	if deck.Rotate(-6); deck[0].Rank != FIVE || deck[1].Rank != ACE {
+		expected := []Rank{FIVE, ACE, TWO, THREE, FOUR}
+		actual := ranks(deck)
+		msg := "Expected the bottom card is moved to the top, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Deck.Reverse()
+// #################################
+
+func TestReverse(t *testing.T) {
+	deck := setupSmallDeck(4)
+
+	if deck.Reverse(); deck[0].Rank != FOUR || deck[3].Rank != ACE {
+		expected := []Rank{FOUR, THREE, TWO, ACE}
+		actual := ranks(deck)
+		msg := "Deck is not reversed"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Deck.Split(), Merge()
+// #################################
+
+func TestSplitAndMerge(t *testing.T) {
+	deck := setupSmallDeck(5)
+	top, bottom, err := deck.Split(3)
+	if err != nil || len(top) != 3 || len(bottom) != 2 {
+		expected := "3 and 2 cards"
+		actual := []Deck{top, bottom}
+		msg := "Deck is not split at index 3"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+
+	merged := Merge(top, bottom)
+	expected := []Rank{ACE, FOUR, TWO, FIVE, THREE}
+	for i, rank := range ranks(merged) {
+		if rank != expected[i] {
+			actual := ranks(merged)
+			msg := "Piles are not interleaved"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test Deck.FaroOut(), Deck.FaroIn()
+// #################################
+
+func TestFaroOut(t *testing.T) {
+	deck := NewDeck()
+	// 8 perfect out-shuffles restore order of 52 cards.
+	for i := 0; i < 8; i++ {
+		deck.FaroOut()
+		if i == 0 && (deck[0] != NewDeck()[0] || deck[1] != NewDeck()[26]) {
+			expected := Deck{NewDeck()[0], NewDeck()[26]}
+			actual := deck[:2]
+			msg := "Expected the top card stays on the top, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+	for i, card := range NewDeck() {
+		if deck[i] != card {
+			expected := NewDeck()
+			actual := deck
+			msg := "Expected 8 out-shuffles restore order of deck, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func TestFaroIn(t *testing.T) {
+	deck := setupSmallDeck(4)
+
+	if deck.FaroIn(); deck[0].Rank != THREE || deck[1].Rank != ACE {
+		expected := []Rank{THREE, ACE, FOUR, TWO}
+		actual := ranks(deck)
+		msg := "Expected the top card goes second, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -7,23 +7,84 @@ import (
 	"strings"
 
 	. "github.com/x-color/gocard"
+	"github.com/x-color/gocard/game"
 )
 
-type Player struct {
-	deck *Deck
-	hand Cards
+const (
+	hit   = "Hit"
+	stand = "Stand"
+)
+
+const (
+	player = iota
+	dealer
+	finished
+)
+
+type blackjackState struct {
+	deck  Deck
+	hands [2]Cards
+	turn  int
+}
+
+func (state blackjackState) Turn() (seat int) {
+	return state.turn
+}
+
+func (state blackjackState) Done() (done bool) {
+	return state.turn == finished
+}
+
+type blackjack struct{}
+
+func (blackjack) Start(players int) (state game.State, err error) {
+	deck := NewDeck()
+	deck.Shuffle()
+	s := blackjackState{deck: deck}
+	for _, seat := range []int{player, player, dealer, dealer} {
+		card, _ := s.deck.Draw()
+		s.hands[seat] = append(s.hands[seat], card)
+	}
+	return s, err
 }
 
-func (player *Player) draw() (card Card, err error) {
-	card, err = player.deck.Draw()
+func (blackjack) Legal(state game.State, seat int) (actions []game.Action) {
+	return []game.Action{hit, stand}
+}
+
+func (blackjack) Apply(state game.State, seat int, action game.Action) (next game.State, err error) {
+	s := state.(blackjackState)
+	s.deck = append(Deck{}, s.deck...)
+	if action == stand {
+		s.turn++
+		return s, err
+	}
+	card, err := s.deck.Draw()
 	if err != nil {
-		return card, err
+		return s, err
+	}
+	s.hands[seat] = append(append(Cards{}, s.hands[seat]...), card)
+	if culcTotalOfCards(s.hands[seat]) > 21 {
+		s.turn = finished
+	}
+	return s, err
+}
+
+func (blackjack) Score(state game.State) (scores []int) {
+	s := state.(blackjackState)
+	totalOfPlayer, totalOfDealer := culcTotalOfCards(s.hands[player]), culcTotalOfCards(s.hands[dealer])
+	result := totalOfPlayer - totalOfDealer
+	switch {
+	case totalOfPlayer > 21:
+		result = -1
+	case totalOfDealer > 21:
+		result = 1
 	}
-	player.hand = append((*player).hand, card)
-	return card, nil
+	return []int{result, -result}
 }
 
 func culcTotalOfCards(cards Cards) (total int) {
+	cards = append(Cards{}, cards...)
 	cards.SortByRank()
 	for _, card := range cards {
 		if int(card.Rank) > 10 || (card.Rank == ACE && total <= 11) {
@@ -54,90 +115,82 @@ func isContinue() (yes bool) {
 	}
 }
 
-func turnOfPlayer(player Player) (total int, burst bool) {
-	fmt.Println("## Start your turn ##")
-	for {
-		hand := player.hand
-		printHand(hand)
-		total := culcTotalOfCards(hand)
-		fmt.Println("Total number of cards is", total)
-		if total > 21 {
-			fmt.Println("Your hand is burst!!")
-			return total, true
-		}
+func turnOfPlayer(state game.State, seat int, legal []game.Action) (action game.Action, err error) {
+	hand := state.(blackjackState).hands[seat]
+	printHand(hand)
+	fmt.Println("Total number of cards is", culcTotalOfCards(hand))
+	fmt.Printf("Do you draw a card from the deck? [y/n]: ")
+	if isContinue() {
+		return hit, err
+	}
+	return stand, err
+}
 
-		fmt.Printf("Do you draw a card from the deck? [y/n]: ")
-		if isContinue() {
-			card, _ := player.draw()
-			fmt.Println("You drew", card)
-			fmt.Println("")
-		} else {
-			fmt.Println("Your turn is finished")
-			return total, burst
-		}
+func turnOfDealer(state game.State, seat int, legal []game.Action) (action game.Action, err error) {
+	hand := state.(blackjackState).hands[seat]
+	printHand(hand)
+	total := culcTotalOfCards(hand)
+	fmt.Println("Total number of cards is", total)
+	if total >= 17 {
+		return stand, err
 	}
+	return hit, err
 }
 
-func turnOfDealer(player Player) (total int, burst bool) {
-	fmt.Println("## Start dealer's turn ##")
-	for {
-		hand := player.hand
+func printEvent(event game.Event) {
+	name := map[int]string{player: "You", dealer: "Dealer"}[event.Seat]
+	after := event.After.(blackjackState)
+	hand := after.hands[event.Seat]
+	switch {
+	case event.Action == stand:
+		fmt.Printf("%s finished the turn\n\n", name)
+	case culcTotalOfCards(hand) > 21:
+		fmt.Println(name, "drew", hand[len(hand)-1])
 		printHand(hand)
-		total := culcTotalOfCards(hand)
-		fmt.Println("Total number of cards is", total)
-		if total > 21 {
-			fmt.Println("Dealer's hand is burst!!")
-			return total, true
-		}
-		if total >= 17 {
-			fmt.Println("Dealer's turn is finished")
-			return total, burst
-		}
-		card, _ := player.draw()
-		fmt.Println("Dealer drew", card)
-		fmt.Println("")
+		fmt.Printf("%s burst!!\n\n", name)
+	default:
+		fmt.Printf("%s drew %s\n\n", name, hand[len(hand)-1])
+	}
+	if after.turn == dealer && event.Seat == player {
+		fmt.Println("## Start dealer's turn ##")
 	}
 }
 
-func startGame(player, dealer Player) (result int) {
+func main() {
+	g, err := game.NewGame(blackjack{}, []game.Player{game.PlayerFunc(turnOfPlayer), game.PlayerFunc(turnOfDealer)})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	g.Subscribe(printEvent)
+
 	fmt.Println("## Start Blackjack!! ##")
 	fmt.Println("## Both Player draw two cards from the deck ##")
-
+	state := g.State.(blackjackState)
 	fmt.Println("Your drawn cards are")
-	player.draw()
-	player.draw()
-	fmt.Println("-", player.hand[0])
-	fmt.Println("-", player.hand[1])
+	fmt.Println("-", state.hands[player][0])
+	fmt.Println("-", state.hands[player][1])
 	fmt.Println("")
-
 	fmt.Println("Dealer's drawn cards are")
-	dealer.draw()
-	dealer.draw()
-	fmt.Println("-", dealer.hand[0])
-	fmt.Println("- Unknown")
-	fmt.Println("")
-
-	totalOfPlayer, burstOfPlayer := turnOfPlayer(player)
-	fmt.Println("")
-	if burstOfPlayer {
-		return -1
+	// Only the first card of dealer is face up
+	hand := Hand(state.hands[dealer], dealer)
+	hand.TurnUp(0)
+	for _, card := range hand.View(player) {
+		if card == Back {
+			fmt.Println("- Unknown")
+		} else {
+			fmt.Println("-", card)
+		}
 	}
-	totalOfDealer, burstOfDealer := turnOfDealer(dealer)
 	fmt.Println("")
-	if burstOfDealer {
-		return 1
-	}
-	return totalOfPlayer - totalOfDealer
-}
-
-func main() {
-	deck := NewDeck()
-	deck.Shuffle()
-	player := Player{deck: &deck}
-	dealer := Player{deck: &deck}
+	fmt.Println("## Start your turn ##")
 
-	result := startGame(player, dealer)
-	switch {
+	scores, err := g.Run()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	switch result := scores[player]; {
 	case result > 0:
 		fmt.Println("## You won!! ##")
 	case result == 0:
@@ -0,0 +1,153 @@
+/*
+Package fair implements provably fair shuffle of deck.
+
+The server commits SHA-256 hash of a server seed before play. The deck is shuffled
+by Fisher-Yates shuffle with random numbers from HMAC-SHA256 of the server seed, a client seed
+and a nonce. After the server reveals the server seed, players verify the commitment and
+reproduce the order of the deck.
+
+Random numbers are uint32 in big endian from HMAC-SHA256(key: server seed, message: "client seed:nonce:round"),
+where round starts from 0 and each HMAC gives 8 numbers. A number for Intn(n) is rejected if it is
+not less than the largest multiple of n under 2^32.
+*/
+package fair
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Seeds is seeds of a shuffle.
+type Seeds struct {
+	Server string
+	Client string
+	Nonce  uint64
+}
+
+// NewServerSeed returns random server seed of 32 bytes in hex. It uses crypto/rand if random is nil.
+func NewServerSeed(random io.Reader) (seed string, err error) {
+	if random == nil {
+		random = rand.Reader
+	}
+	b := make([]byte, 32)
+	if _, err = io.ReadFull(random, b); err != nil {
+		return seed, err
+	}
+	return hex.EncodeToString(b), err
+}
+
+// Commit returns commitment of server seed. It is SHA-256 hash of server seed in hex.
+func Commit(serverSeed string) (commitment string) {
+	hash := sha256.Sum256([]byte(serverSeed))
+	return hex.EncodeToString(hash[:])
+}
+
+// stream is random numbers from seeds.
+type stream struct {
+	seeds   Seeds
+	round   int
+	numbers []uint32
+}
+
+// next returns the next random number.
+func (s *stream) next() (n uint32) {
+	if len(s.numbers) == 0 {
+		mac := hmac.New(sha256.New, []byte(s.seeds.Server))
+		fmt.Fprintf(mac, "%s:%d:%d", s.seeds.Client, s.seeds.Nonce, s.round)
+		sum := mac.Sum(nil)
+		for i := 0; i < len(sum); i += 4 {
+			s.numbers = append(s.numbers, binary.BigEndian.Uint32(sum[i:i+4]))
+		}
+		s.round++
+	}
+	n, s.numbers = s.numbers[0], s.numbers[1:]
+	return n
+}
+
+// intn returns uniform random number in [0, n).
+func (s *stream) intn(n int) (r int) {
+	limit := (1 << 32) / uint64(n) * uint64(n)
+	for {
+		if v := uint64(s.next()); v < limit {
+			return int(v % uint64(n))
+		}
+	}
+}
+
+// Shuffle shuffles deck with seeds. Same seeds shuffle the deck in same order.
+func Shuffle(deck gocard.Deck, seeds Seeds) {
+	s := &stream{seeds: seeds}
+	for i := len(deck); i > 0; i-- {
+		randIndex := s.intn(i)
+		deck[i-1], deck[randIndex] = deck[randIndex], deck[i-1]
+	}
+}
+
+// Verify verifies that shuffled is deck shuffled with seeds and server seed of seeds matches commitment.
+// It returns error if the shuffle is not fair.
+func Verify(deck gocard.Deck, shuffled gocard.Deck, seeds Seeds, commitment string) (err error) {
+	if Commit(seeds.Server) != commitment {
+		return errors.New("couldn't verify, server seed doesn't match commitment")
+	}
+	expected := append(gocard.Deck{}, deck...)
+	Shuffle(expected, seeds)
+	if len(expected) != len(shuffled) {
+		return errors.New("couldn't verify, size of shuffled deck is different")
+	}
+	for i := range expected {
+		if expected[i] != shuffled[i] {
+			return fmt.Errorf("couldn't verify, card %d is %s but expected %s", i, shuffled[i], expected[i])
+		}
+	}
+	return err
+}
+
+// Server is a dealer of provably fair shuffles.
+// Commitment of the current server seed is published before play, and ClientSeed is set by the player.
+type Server struct {
+	Commitment string
+	ClientSeed string
+	Nonce      uint64
+	seed       string
+	random     io.Reader
+}
+
+// NewServer returns server with a new server seed. It uses crypto/rand if random is nil.
+func NewServer(clientSeed string, random io.Reader) (server *Server, err error) {
+	server = &Server{ClientSeed: clientSeed, random: random}
+	if server.seed, err = NewServerSeed(random); err != nil {
+		return server, err
+	}
+	server.Commitment = Commit(server.seed)
+	return server, err
+}
+
+// Shuffle shuffles deck with current seeds and increments the nonce, and returns seeds used.
+// Server of returned seeds is empty because the server seed is secret until Reveal.
+func (server *Server) Shuffle(deck gocard.Deck) (seeds Seeds) {
+	Shuffle(deck, Seeds{Server: server.seed, Client: server.ClientSeed, Nonce: server.Nonce})
+	seeds = Seeds{Client: server.ClientSeed, Nonce: server.Nonce}
+	server.Nonce++
+	return seeds
+}
+
+// Reveal reveals current server seed, and commits a new server seed.
+// Shuffles by the revealed seed can be verified after it.
+// It returns error without revealing the seed if a new server seed couldn't be made.
+func (server *Server) Reveal() (seed string, err error) {
+	next, err := NewServerSeed(server.random)
+	if err != nil {
+		return seed, err
+	}
+	seed = server.seed
+	server.seed, server.Commitment, server.Nonce = next, Commit(next), 0
+	return seed, err
+}
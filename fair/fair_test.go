@@ -0,0 +1,140 @@
+package fair
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test Shuffle()
+// #################################
+
+func TestShuffleDeterministic(t *testing.T) {
+	seeds := Seeds{Server: "server", Client: "client", Nonce: 1}
+	deck1, deck2 := gocard.NewDeck(), gocard.NewDeck()
+	Shuffle(deck1, seeds)
+	Shuffle(deck2, seeds)
+
+	for i := range deck1 {
+		if deck1[i] != deck2[i] {
+			expected := deck1[i]
+			actual := deck2[i]
+			msg := "Expected decks shuffled with same seeds are same, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+
+	seeds.Nonce++
+	deck3 := gocard.NewDeck()
+	Shuffle(deck3, seeds)
+	same := true
+	for i := range deck1 {
+		same = same && deck1[i] == deck3[i]
+	}
+	if same {
+		expected := false
+		actual := same
+		msg := "Expected decks shuffled with different nonces are different, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestShuffleKnownOrder(t *testing.T) {
+	deck := gocard.NewDeck()
+	Shuffle(deck, Seeds{Server: "server", Client: "client"})
+
+	expected := gocard.Cards{
+		{Rank: gocard.JACK, Suit: gocard.HEARTS},
+		{Rank: gocard.THREE, Suit: gocard.SPADES},
+		{Rank: gocard.SIX, Suit: gocard.DIAMONDS},
+	}
+	for i := range expected {
+		if deck[i] != expected[i] {
+			actual := deck[:3]
+			msg := "Shuffled deck is not same as documented algorithm"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test Verify()
+// #################################
+
+func TestVerify(t *testing.T) {
+	server, err := NewServer("lucky", nil)
+	if err != nil {
+		t.Fatalf("Couldn't make server\nError: %v", err)
+	}
+	commitment := server.Commitment
+	deck := gocard.NewDeck()
+	seeds := server.Shuffle(deck)
+	if seeds.Server != "" {
+		expected := ""
+		actual := seeds.Server
+		msg := "Expected server seed is secret before reveal, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	seed, _ := server.Reveal()
+
+	if Commit(seed) != commitment || server.Commitment == commitment || server.Nonce != 0 {
+		expected := commitment
+		actual := Commit(seed)
+		msg := "Expected server reveals the seed and commits a new seed, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	seeds.Server = seed
+	if err := Verify(gocard.NewDeck(), deck, seeds, commitment); err != nil {
+		t.Fatalf("Couldn't verify fair shuffle\nError: %v", err)
+	}
+}
+
+func TestVerifyRigged(t *testing.T) {
+	seeds := Seeds{Server: "server", Client: "client"}
+	deck := gocard.NewDeck()
+	Shuffle(deck, seeds)
+	deck[0], deck[1] = deck[1], deck[0]
+
+	if err := Verify(gocard.NewDeck(), deck, seeds, Commit("server")); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as verifying rigged deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := Verify(gocard.NewDeck(), deck, seeds, Commit("other")); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as verifying seed different from commitment"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Server.Reveal()
+// #################################
+
+func TestRevealFailure(t *testing.T) {
+	// The reader has only 32 bytes for the first server seed.
+	server, err := NewServer("lucky", io.LimitReader(bytes.NewReader(make([]byte, 64)), 32))
+	if err != nil {
+		t.Fatalf("Couldn't make server\nError: %v", err)
+	}
+	commitment := server.Commitment
+	server.Shuffle(gocard.NewDeck())
+
+	if seed, err := server.Reveal(); err == nil || seed != "" {
+		expected := "error and no seed"
+		actual := seed
+		msg := "Couldn't catch error as making new server seed"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if server.Commitment != commitment || server.Nonce != 1 {
+		expected := commitment
+		actual := server.Commitment
+		msg := "Expected server keeps unrevealed seed after failure, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
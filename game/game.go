@@ -0,0 +1,163 @@
+/*
+Package game is a framework of turn-based card games.
+
+Rules of a game define states, legal actions and results, and Players choose actions.
+Game runs the loop of turns, keeps snapshots of states and emits events of actions.
+*/
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Action is an action of a player. It must be comparable. (e.g. a string or a struct of values)
+type Action interface{}
+
+// State is an immutable snapshot of a game. Rules must not change states given to them.
+type State interface {
+	// Turn returns seat of the player to act.
+	Turn() (seat int)
+	// Done returns whether the game is over.
+	Done() (done bool)
+}
+
+// Rules is rules of a game.
+type Rules interface {
+	// Start returns the first state of a game for players players.
+	Start(players int) (state State, err error)
+	// Legal returns legal actions of the player of seat in state.
+	Legal(state State, seat int) (actions []Action)
+	// Apply returns the next state after the player of seat acts action in state. It must not change state.
+	Apply(state State, seat int, action Action) (next State, err error)
+	// Score returns scores of each player in state of the end.
+	Score(state State) (scores []int)
+}
+
+// Player is a player which chooses an action from legal actions.
+type Player interface {
+	Act(state State, seat int, legal []Action) (action Action, err error)
+}
+
+// PlayerFunc is a function as Player.
+type PlayerFunc func(state State, seat int, legal []Action) (action Action, err error)
+
+// Act calls f.
+func (f PlayerFunc) Act(state State, seat int, legal []Action) (action Action, err error) {
+	return f(state, seat, legal)
+}
+
+// Event is an action acted in a game. Before and After are states before and after the action.
+type Event struct {
+	Step   int
+	Seat   int
+	Action Action
+	Before State
+	After  State
+}
+
+// String returns string of event. (e.g. 1: seat 0 Hit)
+func (event Event) String() (msg string) {
+	return fmt.Sprintf("%d: seat %d %v", event.Step, event.Seat, event.Action)
+}
+
+// Game is a game played by players with rules.
+type Game struct {
+	Rules   Rules
+	Players []Player
+	State   State
+	History []Event
+	// listeners are called with each event.
+	listeners []func(event Event)
+}
+
+// NewGame starts a game of rules with players, and returns error of rules.
+func NewGame(rules Rules, players []Player) (game *Game, err error) {
+	if len(players) == 0 {
+		err = errors.New("couldn't start game, no players")
+		return game, err
+	}
+	state, err := rules.Start(len(players))
+	if err != nil {
+		return game, err
+	}
+	return &Game{Rules: rules, Players: players, State: state}, err
+}
+
+// Subscribe adds listener which is called with each event after the action is applied.
+func (game *Game) Subscribe(listener func(event Event)) {
+	game.listeners = append(game.listeners, listener)
+}
+
+// Legal returns legal actions of the player to act in current state.
+func (game *Game) Legal() (actions []Action) {
+	return game.Rules.Legal(game.State, game.State.Turn())
+}
+
+// Act applies action of the player to act, and returns error if action is illegal.
+func (game *Game) Act(action Action) (err error) {
+	if game.State.Done() {
+		return errors.New("couldn't act, game is over")
+	}
+	seat := game.State.Turn()
+	legal := false
+	for _, a := range game.Rules.Legal(game.State, seat) {
+		legal = legal || a == action
+	}
+	if !legal {
+		return fmt.Errorf("couldn't act, %v is illegal for seat %d", action, seat)
+	}
+	next, err := game.Rules.Apply(game.State, seat, action)
+	if err != nil {
+		return err
+	}
+	event := Event{Step: len(game.History) + 1, Seat: seat, Action: action, Before: game.State, After: next}
+	game.History = append(game.History, event)
+	game.State = next
+	for _, listener := range game.listeners {
+		listener(event)
+	}
+	return err
+}
+
+// Step asks the player to act for an action and applies it, and returns error of the player or the action.
+func (game *Game) Step() (err error) {
+	if game.State.Done() {
+		return errors.New("couldn't step, game is over")
+	}
+	seat := game.State.Turn()
+	if seat < 0 || seat >= len(game.Players) {
+		return fmt.Errorf("couldn't step, seat %d has no player", seat)
+	}
+	action, err := game.Players[seat].Act(game.State, seat, game.Legal())
+	if err != nil {
+		return err
+	}
+	return game.Act(action)
+}
+
+// Run steps until the game is over, and returns scores of players.
+func (game *Game) Run() (scores []int, err error) {
+	for !game.State.Done() {
+		if err = game.Step(); err != nil {
+			return scores, err
+		}
+	}
+	return game.Rules.Score(game.State), err
+}
+
+// Snapshot returns state after step actions. Snapshot(0) is the first state.
+// It returns error if step is out of history.
+func (game *Game) Snapshot(step int) (state State, err error) {
+	switch {
+	case step < 0 || step > len(game.History):
+		err = fmt.Errorf("couldn't get snapshot, step %d is out of history", step)
+		return state, err
+	case step == 0 && len(game.History) == 0:
+		return game.State, err
+	case step == 0:
+		return game.History[0].Before, err
+	default:
+		return game.History[step-1].After, err
+	}
+}
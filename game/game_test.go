@@ -0,0 +1,145 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+
+// drawState is a state of a game which players draw cards in turn until each player has 2 cards.
+type drawState struct {
+	deck  gocard.Deck
+	hands []gocard.Cards
+	turn  int
+}
+
+func (state drawState) Turn() (seat int) { return state.turn }
+func (state drawState) Done() (done bool) {
+	return len(state.hands[len(state.hands)-1]) == 2
+}
+
+// drawRules is rules of drawState. The player who has the largest total rank wins.
+type drawRules struct{}
+
+func (drawRules) Start(players int) (state State, err error) {
+	return drawState{deck: gocard.NewDeck(), hands: make([]gocard.Cards, players)}, err
+}
+
+func (drawRules) Legal(state State, seat int) (actions []Action) {
+	return []Action{"draw", "burn"}
+}
+
+func (drawRules) Apply(state State, seat int, action Action) (next State, err error) {
+	s := state.(drawState)
+	deck := append(gocard.Deck{}, s.deck...)
+	hands := append([]gocard.Cards{}, s.hands...)
+	if action == "burn" {
+		deck.Draw()
+	}
+	card, err := deck.Draw()
+	hands[seat] = append(append(gocard.Cards{}, hands[seat]...), card)
+	return drawState{deck: deck, hands: hands, turn: (seat + 1) % len(hands)}, err
+}
+
+func (drawRules) Score(state State) (scores []int) {
+	for _, hand := range state.(drawState).hands {
+		total := 0
+		for _, card := range hand {
+			total += int(card.Rank)
+		}
+		scores = append(scores, total)
+	}
+	return scores
+}
+
+func setupPlayers(actions ...Action) (players []Player) {
+	for _, action := range actions {
+		a := action
+		players = append(players, PlayerFunc(func(state State, seat int, legal []Action) (Action, error) {
+			return a, nil
+		}))
+	}
+	return players
+}
+
+// #################################
+// Test Game.Run()
+// #################################
+
+func TestRun(t *testing.T) {
+	game, err := NewGame(drawRules{}, setupPlayers("draw", "burn"))
+	if err != nil {
+		t.Fatalf("Couldn't start game\nError: %v", err)
+	}
+	var events []Event
+	game.Subscribe(func(event Event) { events = append(events, event) })
+
+	scores, err := game.Run()
+	if err != nil || scores[0] != 1+4 || scores[1] != 3+6 {
+		expected := []int{5, 9}
+		actual := scores
+		msg := "Scores of game are not expected scores"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if len(events) != 4 || len(game.History) != 4 || events[3].Seat != 1 {
+		expected := 4
+		actual := len(events)
+		msg := "Events are not emitted for each action"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestRunIllegalAction(t *testing.T) {
+	game, _ := NewGame(drawRules{}, setupPlayers("draw", "pass"))
+	if _, err := game.Run(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as acting illegal action"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestRunPlayerError(t *testing.T) {
+	players := []Player{PlayerFunc(func(state State, seat int, legal []Action) (Action, error) {
+		return nil, errors.New("disconnected")
+	})}
+	game, _ := NewGame(drawRules{}, players)
+	if _, err := game.Run(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error of player"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Game.Snapshot()
+// #################################
+
+func TestSnapshot(t *testing.T) {
+	game, _ := NewGame(drawRules{}, setupPlayers("draw", "draw"))
+	game.Run()
+
+	first, err := game.Snapshot(0)
+	if err != nil || len(first.(drawState).deck) != 52 || len(first.(drawState).hands[0]) != 0 {
+		expected := 52
+		actual := first
+		msg := "Expected the first snapshot is not changed by actions, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if state, _ := game.Snapshot(2); len(state.(drawState).deck) != 50 {
+		expected := 50
+		actual := len(state.(drawState).deck)
+		msg := "Snapshot after 2 steps is not expected state"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if _, err := game.Snapshot(5); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as getting snapshot out of history"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
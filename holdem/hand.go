@@ -0,0 +1,160 @@
+package holdem
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Category is category of poker hand. (HIGHCARD ~ STRAIGHTFLUSH)
+type Category int
+
+// These constant values are categories of poker hand.
+const (
+	HIGHCARD Category = iota + 1
+	ONEPAIR
+	TWOPAIR
+	THREEOFAKIND
+	STRAIGHT
+	FLUSH
+	FULLHOUSE
+	FOUROFAKIND
+	STRAIGHTFLUSH
+)
+
+// String returns string of category. (e.g. Full House)
+func (category Category) String() (msg string) {
+	switch category {
+	case HIGHCARD:
+		return "High Card"
+	case ONEPAIR:
+		return "One Pair"
+	case TWOPAIR:
+		return "Two Pair"
+	case THREEOFAKIND:
+		return "Three of a Kind"
+	case STRAIGHT:
+		return "Straight"
+	case FLUSH:
+		return "Flush"
+	case FULLHOUSE:
+		return "Full House"
+	case FOUROFAKIND:
+		return "Four of a Kind"
+	case STRAIGHTFLUSH:
+		return "Straight Flush"
+	default:
+		return "Unknown"
+	}
+}
+
+// Hand is value of the best 5-card poker hand.
+// Ranks are rank values to break ties in order of importance. (Two: 2 ~ Ace: 14)
+type Hand struct {
+	Category Category
+	Ranks    [5]int
+	Cards    gocard.Cards
+}
+
+// String returns string of hand. (e.g. Full House [Ace of Spades ...])
+func (hand Hand) String() (msg string) {
+	return fmt.Sprintf("%s %v", hand.Category, hand.Cards)
+}
+
+// Compare compares two hands and returns diff of hands.
+// Return diff > 0 (hand1 > hand2), diff = 0 (hand1 == hand2), diff < 0 (hand1 < hand2)
+func Compare(hand1 Hand, hand2 Hand) (diff int) {
+	if diff = int(hand1.Category) - int(hand2.Category); diff != 0 {
+		return diff
+	}
+	for i := range hand1.Ranks {
+		if diff = hand1.Ranks[i] - hand2.Ranks[i]; diff != 0 {
+			return diff
+		}
+	}
+	return diff
+}
+
+// rankValue returns value of rank for poker. (Two: 2 ~ King: 13, Ace: 14)
+func rankValue(rank gocard.Rank) (value int) {
+	if rank == gocard.ACE {
+		return 14
+	}
+	return int(rank)
+}
+
+// Evaluate returns the best 5-card hand of 5 ~ 7 cards, and returns error if number of cards is invalid.
+func Evaluate(cards gocard.Cards) (best Hand, err error) {
+	if len(cards) < 5 || len(cards) > 7 {
+		err = errors.New("couldn't evaluate, number of cards must be 5 ~ 7")
+		return best, err
+	}
+	for mask := 0; mask < 1<<uint(len(cards)); mask++ {
+		var five gocard.Cards
+		for i, card := range cards {
+			if mask&(1<<uint(i)) != 0 {
+				five = append(five, card)
+			}
+		}
+		if len(five) != 5 {
+			continue
+		}
+		if hand := evaluate5(five); best.Category == 0 || Compare(hand, best) > 0 {
+			best = hand
+		}
+	}
+	return best, err
+}
+
+// evaluate5 returns value of 5 cards.
+func evaluate5(cards gocard.Cards) (hand Hand) {
+	hand.Cards = cards
+	counts := map[int]int{}
+	flush := true
+	for _, card := range cards {
+		counts[rankValue(card.Rank)]++
+		flush = flush && card.Suit == cards[0].Suit
+	}
+	// Ranks are sorted by count, and by rank in same count.
+	var ranks []int
+	for rank := range counts {
+		ranks = append(ranks, rank)
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if counts[ranks[i]] != counts[ranks[j]] {
+			return counts[ranks[i]] > counts[ranks[j]]
+		}
+		return ranks[i] > ranks[j]
+	})
+	copy(hand.Ranks[:], ranks)
+
+	straight := len(ranks) == 5 && ranks[0]-ranks[4] == 4
+	if len(ranks) == 5 && ranks[0] == 14 && ranks[1] == 5 {
+		// Wheel (Ace, Two, Three, Four, Five) is the lowest straight.
+		straight = true
+		hand.Ranks = [5]int{5, 4, 3, 2, 1}
+	}
+	switch {
+	case straight && flush:
+		hand.Category = STRAIGHTFLUSH
+	case counts[ranks[0]] == 4:
+		hand.Category = FOUROFAKIND
+	case counts[ranks[0]] == 3 && counts[ranks[1]] == 2:
+		hand.Category = FULLHOUSE
+	case flush:
+		hand.Category = FLUSH
+	case straight:
+		hand.Category = STRAIGHT
+	case counts[ranks[0]] == 3:
+		hand.Category = THREEOFAKIND
+	case counts[ranks[0]] == 2 && counts[ranks[1]] == 2:
+		hand.Category = TWOPAIR
+	case counts[ranks[0]] == 2:
+		hand.Category = ONEPAIR
+	default:
+		hand.Category = HIGHCARD
+	}
+	return hand
+}
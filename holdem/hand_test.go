@@ -0,0 +1,92 @@
+package holdem
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupCards(cards ...interface{}) (result gocard.Cards) {
+	for i := 0; i < len(cards); i += 2 {
+		result = append(result, gocard.Card{Rank: cards[i].(gocard.Rank), Suit: cards[i+1].(gocard.Suit)})
+	}
+	return result
+}
+
+// #################################
+// Test Evaluate()
+// #################################
+
+func TestEvaluateCategories(t *testing.T) {
+	tests := []struct {
+		cards    gocard.Cards
+		category Category
+	}{
+		{setupCards(gocard.ACE, gocard.SPADES, gocard.KING, gocard.SPADES, gocard.QUEEN, gocard.SPADES, gocard.JACK, gocard.SPADES, gocard.TEN, gocard.SPADES), STRAIGHTFLUSH},
+		{setupCards(gocard.NINE, gocard.SPADES, gocard.NINE, gocard.HEARTS, gocard.NINE, gocard.CLUBS, gocard.NINE, gocard.DIAMONDS, gocard.TWO, gocard.SPADES), FOUROFAKIND},
+		{setupCards(gocard.NINE, gocard.SPADES, gocard.NINE, gocard.HEARTS, gocard.NINE, gocard.CLUBS, gocard.TWO, gocard.DIAMONDS, gocard.TWO, gocard.SPADES), FULLHOUSE},
+		{setupCards(gocard.ACE, gocard.HEARTS, gocard.TWO, gocard.CLUBS, gocard.THREE, gocard.SPADES, gocard.FOUR, gocard.DIAMONDS, gocard.FIVE, gocard.SPADES), STRAIGHT},
+		{setupCards(gocard.ACE, gocard.HEARTS, gocard.ACE, gocard.CLUBS, gocard.THREE, gocard.SPADES, gocard.THREE, gocard.DIAMONDS, gocard.FIVE, gocard.SPADES), TWOPAIR},
+		{setupCards(gocard.ACE, gocard.HEARTS, gocard.JACK, gocard.CLUBS, gocard.THREE, gocard.SPADES, gocard.EIGHT, gocard.DIAMONDS, gocard.FIVE, gocard.SPADES), HIGHCARD},
+	}
+	for _, test := range tests {
+		if hand, err := Evaluate(test.cards); err != nil || hand.Category != test.category {
+			expected := test.category
+			actual := hand.Category
+			msg := "Category of hand is not expected category"
+			t.Fatalf("%s (%v)\nExpected: %v\nActual  : %v (%v)", msg, test.cards, expected, actual, err)
+		}
+	}
+}
+
+func TestEvaluateSevenCards(t *testing.T) {
+	cards := setupCards(
+		gocard.ACE, gocard.HEARTS, gocard.KING, gocard.HEARTS,
+		gocard.TWO, gocard.HEARTS, gocard.KING, gocard.CLUBS, gocard.SEVEN, gocard.HEARTS, gocard.NINE, gocard.HEARTS, gocard.KING, gocard.SPADES,
+	)
+
+	if hand, err := Evaluate(cards); err != nil || hand.Category != FLUSH || hand.Ranks != [5]int{14, 13, 9, 7, 2} {
+		expected := "Flush [14 13 9 7 2]"
+		actual := hand
+		msg := "Expected the best hand of 7 cards is flush, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v %v (%v)", msg, expected, actual, actual.Ranks, err)
+	}
+}
+
+func TestEvaluateInvalid(t *testing.T) {
+	if _, err := Evaluate(gocard.Cards(gocard.NewDeck()[:4])); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as evaluating 4 cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Compare()
+// #################################
+
+func TestCompareKicker(t *testing.T) {
+	hand1, _ := Evaluate(setupCards(gocard.ACE, gocard.HEARTS, gocard.ACE, gocard.CLUBS, gocard.KING, gocard.SPADES, gocard.EIGHT, gocard.DIAMONDS, gocard.FIVE, gocard.SPADES))
+	hand2, _ := Evaluate(setupCards(gocard.ACE, gocard.SPADES, gocard.ACE, gocard.DIAMONDS, gocard.QUEEN, gocard.SPADES, gocard.EIGHT, gocard.CLUBS, gocard.FIVE, gocard.HEARTS))
+
+	if diff := Compare(hand1, hand2); diff <= 0 {
+		expected := "diff > 0"
+		actual := diff
+		msg := "Expected pair of Aces with King kicker beats Queen kicker, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestCompareWheel(t *testing.T) {
+	wheel, _ := Evaluate(setupCards(gocard.ACE, gocard.HEARTS, gocard.TWO, gocard.CLUBS, gocard.THREE, gocard.SPADES, gocard.FOUR, gocard.DIAMONDS, gocard.FIVE, gocard.SPADES))
+	six, _ := Evaluate(setupCards(gocard.SIX, gocard.HEARTS, gocard.TWO, gocard.CLUBS, gocard.THREE, gocard.SPADES, gocard.FOUR, gocard.DIAMONDS, gocard.FIVE, gocard.SPADES))
+
+	if diff := Compare(wheel, six); diff >= 0 {
+		expected := "diff < 0"
+		actual := diff
+		msg := "Expected wheel is the lowest straight, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,119 @@
+package holdem
+
+import (
+	gocard "github.com/x-color/gocard"
+)
+
+// Pot is a main pot or a side pot. Eligible is seats of players who can win the pot.
+type Pot struct {
+	Amount   int
+	Eligible []int
+}
+
+// Pots returns the main pot and side pots built from chips committed by players.
+// Chips of folded players go to pots, but folded players are not eligible.
+func (table *Table) Pots() (pots []Pot) {
+	committed := make([]int, len(table.Players))
+	for i, player := range table.Players {
+		committed[i] = player.Committed
+	}
+	for {
+		level := 0
+		for i, player := range table.Players {
+			if !player.Folded && committed[i] > 0 && (level == 0 || committed[i] < level) {
+				level = committed[i]
+			}
+		}
+		if level == 0 {
+			break
+		}
+		pot := Pot{}
+		for i, player := range table.Players {
+			if committed[i] == 0 {
+				continue
+			}
+			amount := committed[i]
+			if amount > level {
+				amount = level
+			}
+			pot.Amount += amount
+			committed[i] -= amount
+			if !player.Folded {
+				pot.Eligible = append(pot.Eligible, i)
+			}
+		}
+		if n := len(pots); n > 0 && equalSeats(pots[n-1].Eligible, pot.Eligible) {
+			pots[n-1].Amount += pot.Amount
+		} else {
+			pots = append(pots, pot)
+		}
+	}
+	// Chips of folded players over all other players go to the last pot.
+	for i := range committed {
+		if len(pots) > 0 {
+			pots[len(pots)-1].Amount += committed[i]
+		}
+	}
+	return pots
+}
+
+// equalSeats returns whether two lists of seats are same.
+func equalSeats(seats1 []int, seats2 []int) (ok bool) {
+	if len(seats1) != len(seats2) {
+		return false
+	}
+	for i := range seats1 {
+		if seats1[i] != seats2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// showdown ends the hand, and awards each pot to the best hands of eligible players.
+// Odd chips go to the first winner left of the button.
+func (table *Table) showdown() {
+	table.Street, table.turn = SHOWDOWN, -1
+	table.Winnings = make([]int, len(table.Players))
+	hands := map[int]Hand{}
+	for _, pot := range table.Pots() {
+		var winners []int
+		for i := 1; i <= len(table.Players); i++ {
+			seat := (table.Button + i) % len(table.Players)
+			if !contains(pot.Eligible, seat) {
+				continue
+			}
+			if len(pot.Eligible) == 1 {
+				winners = []int{seat}
+				break
+			}
+			if _, ok := hands[seat]; !ok {
+				hands[seat], _ = Evaluate(append(append(gocard.Cards{}, table.Players[seat].Hole...), table.Board...))
+			}
+			switch {
+			case len(winners) == 0 || Compare(hands[seat], hands[winners[0]]) > 0:
+				winners = []int{seat}
+			case Compare(hands[seat], hands[winners[0]]) == 0:
+				winners = append(winners, seat)
+			}
+		}
+		for i, seat := range winners {
+			share := pot.Amount / len(winners)
+			if i == 0 {
+				share += pot.Amount % len(winners)
+			}
+			table.Winnings[seat] += share
+			table.Players[seat].Stack += share
+		}
+	}
+}
+
+// contains returns whether seats has seat.
+func contains(seats []int, seat int) (ok bool) {
+	for _, s := range seats {
+		if s == seat {
+			return true
+		}
+	}
+	return false
+}
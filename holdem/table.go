@@ -0,0 +1,408 @@
+/*
+Package holdem implements a dealer of Texas Hold'em.
+
+It deals hole cards and the board with burn cards, posts blinds and antes,
+validates bets of no-limit, pot-limit and fixed-limit, builds side pots and settles showdown.
+*/
+package holdem
+
+import (
+	"errors"
+	"fmt"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Limit is betting structure. (NOLIMIT, POTLIMIT, FIXEDLIMIT)
+type Limit int
+
+// These constant values are betting structures.
+const (
+	NOLIMIT Limit = iota + 1
+	POTLIMIT
+	FIXEDLIMIT
+)
+
+// Street is betting round. (PREFLOP, FLOP, TURN, RIVER, SHOWDOWN)
+// SHOWDOWN means the hand is over.
+type Street int
+
+// These constant values are betting rounds.
+const (
+	PREFLOP Street = iota + 1
+	FLOP
+	TURN
+	RIVER
+	SHOWDOWN
+)
+
+// String returns string of street. (e.g. Flop)
+func (street Street) String() (msg string) {
+	switch street {
+	case PREFLOP:
+		return "Preflop"
+	case FLOP:
+		return "Flop"
+	case TURN:
+		return "Turn"
+	case RIVER:
+		return "River"
+	case SHOWDOWN:
+		return "Showdown"
+	default:
+		return "Unknown"
+	}
+}
+
+// ActionType is type of action. (FOLD, CHECK, CALL, BET, RAISE)
+type ActionType int
+
+// These constant values are types of action.
+const (
+	FOLD ActionType = iota + 1
+	CHECK
+	CALL
+	BET
+	RAISE
+)
+
+// String returns string of type of action. (e.g. Raise)
+func (t ActionType) String() (msg string) {
+	switch t {
+	case FOLD:
+		return "Fold"
+	case CHECK:
+		return "Check"
+	case CALL:
+		return "Call"
+	case BET:
+		return "Bet"
+	case RAISE:
+		return "Raise"
+	default:
+		return "Unknown"
+	}
+}
+
+// Action is an action of a player. Amount is total bet of the player in the street after BET or RAISE.
+type Action struct {
+	Type   ActionType
+	Amount int
+}
+
+// Player is a player at the table.
+// Bet is chips bet in current street, and Committed is chips put in the pot in current hand.
+type Player struct {
+	Stack     int
+	Hole      gocard.Cards
+	Bet       int
+	Committed int
+	Folded    bool
+	AllIn     bool
+	acted     bool
+}
+
+// active returns whether player can act.
+func (player *Player) active() (ok bool) {
+	return !player.Folded && !player.AllIn
+}
+
+// put moves amount chips from stack of player to the pot. If stack is short, player goes all-in.
+func (player *Player) put(amount int) {
+	if amount >= player.Stack {
+		amount = player.Stack
+		player.AllIn = true
+	}
+	player.Stack -= amount
+	player.Bet += amount
+	player.Committed += amount
+}
+
+// Config is stakes of the table.
+type Config struct {
+	SmallBlind int
+	BigBlind   int
+	Ante       int
+	Limit      Limit
+}
+
+// Table is a table of Texas Hold'em.
+// Burned is burn cards, and Winnings is chips won by each player in the last showdown.
+type Table struct {
+	Config
+	Players  []*Player
+	Button   int
+	Deck     gocard.Deck
+	Board    gocard.Cards
+	Burned   gocard.Cards
+	Street   Street
+	Winnings []int
+	turn     int
+	current  int
+	minRaise int
+	bets     int
+	hands    int
+}
+
+// NewTable returns table with players of stacks, and returns error if config or stacks are invalid.
+func NewTable(config Config, stacks []int) (table *Table, err error) {
+	if len(stacks) < 2 || len(stacks) > 10 {
+		err = errors.New("couldn't make table, number of players must be 2 ~ 10")
+		return table, err
+	}
+	if config.SmallBlind <= 0 || config.BigBlind < config.SmallBlind || config.Ante < 0 {
+		err = errors.New("couldn't make table, blinds are invalid")
+		return table, err
+	}
+	if config.Limit < NOLIMIT || config.Limit > FIXEDLIMIT {
+		err = errors.New("couldn't make table, limit is invalid")
+		return table, err
+	}
+	table = &Table{Config: config, Street: SHOWDOWN}
+	for _, stack := range stacks {
+		table.Players = append(table.Players, &Player{Stack: stack})
+	}
+	return table, err
+}
+
+// next returns the next seat from seat which satisfies ok.
+func (table *Table) next(seat int, ok func(player *Player) bool) (next int) {
+	for i := 1; i <= len(table.Players); i++ {
+		next = (seat + i) % len(table.Players)
+		if ok(table.Players[next]) {
+			return next
+		}
+	}
+	return -1
+}
+
+// count returns number of players which satisfy ok.
+func (table *Table) count(ok func(player *Player) bool) (n int) {
+	for _, player := range table.Players {
+		if ok(player) {
+			n++
+		}
+	}
+	return n
+}
+
+// StartHand moves the button, posts antes and blinds and deals hole cards from deck.
+// Players without chips sit out. It returns error if the last hand is not over, players are short
+// or deck doesn't have enough cards for the hand.
+func (table *Table) StartHand(deck gocard.Deck) (err error) {
+	if table.Street != SHOWDOWN {
+		return errors.New("couldn't start hand, the last hand is not over")
+	}
+	players := table.count(func(p *Player) bool { return p.Stack > 0 })
+	if players < 2 {
+		return errors.New("couldn't start hand, less than 2 players have chips")
+	}
+	// Hole cards, 3 burn cards and 5 cards of the board must be dealt before any chips are posted.
+	if len(deck) < 2*players+8 {
+		return fmt.Errorf("couldn't start hand, deck has %d cards but %d cards are needed", len(deck), 2*players+8)
+	}
+	for _, player := range table.Players {
+		*player = Player{Stack: player.Stack, Folded: player.Stack == 0}
+	}
+	if table.hands > 0 || table.Players[table.Button].Folded {
+		table.Button = table.next(table.Button, (*Player).active)
+	}
+	table.hands++
+	table.Deck, table.Board, table.Burned, table.Winnings = deck, nil, nil, nil
+
+	for _, player := range table.Players {
+		if player.active() && table.Ante > 0 {
+			player.put(table.Ante)
+			player.Bet = 0
+		}
+	}
+	small := table.next(table.Button, (*Player).active)
+	if table.count((*Player).active) == 2 {
+		// Heads-up: the button posts the small blind.
+		small = table.Button
+	}
+	big := table.next(small, (*Player).active)
+	table.Players[small].put(table.SmallBlind)
+	table.Players[big].put(table.BigBlind)
+
+	for round := 0; round < 2; round++ {
+		for i := 1; i <= len(table.Players); i++ {
+			player := table.Players[(table.Button+i)%len(table.Players)]
+			if player.Folded {
+				continue
+			}
+			card, _ := table.Deck.Draw()
+			player.Hole = append(player.Hole, card)
+		}
+	}
+
+	table.Street = PREFLOP
+	table.current, table.minRaise, table.bets = table.BigBlind, table.BigBlind, 1
+	table.turn = big
+	table.advance()
+	return err
+}
+
+// Turn returns seat of the player to act. It is -1 if no one can act.
+func (table *Table) Turn() (seat int) {
+	return table.turn
+}
+
+// Done returns whether the hand is over.
+func (table *Table) Done() (done bool) {
+	return table.Street == SHOWDOWN
+}
+
+// Pot returns total chips in the pot.
+func (table *Table) Pot() (pot int) {
+	for _, player := range table.Players {
+		pot += player.Committed
+	}
+	return pot
+}
+
+// betSize returns bet size of fixed-limit in current street.
+func (table *Table) betSize() (size int) {
+	if table.Street >= TURN {
+		return 2 * table.BigBlind
+	}
+	return table.BigBlind
+}
+
+// RaiseRange returns min and max total bet of BET or RAISE by the player to act.
+// It returns error if the player couldn't bet or raise.
+func (table *Table) RaiseRange() (min int, max int, err error) {
+	if table.turn < 0 {
+		err = errors.New("couldn't raise, no one can act")
+		return min, max, err
+	}
+	player := table.Players[table.turn]
+	all := player.Bet + player.Stack
+	if all <= table.current || (table.Limit == FIXEDLIMIT && table.bets >= 4) {
+		err = errors.New("couldn't raise, stack is short or betting is capped")
+		return min, max, err
+	}
+	// A player who has acted faces only all-in for less than a full raise, so betting is not reopened.
+	if player.acted {
+		err = errors.New("couldn't raise, betting is not reopened by all-in for less than a full raise")
+		return min, max, err
+	}
+	switch table.Limit {
+	case FIXEDLIMIT:
+		min = table.current + table.betSize()
+		max = min
+	case POTLIMIT:
+		min = table.current + table.minRaise
+		max = table.current + table.Pot() + table.current - player.Bet
+	default:
+		min = table.current + table.minRaise
+		max = all
+	}
+	if max > all {
+		max = all
+	}
+	if min > all {
+		// All-in for less than a full raise
+		min = all
+	}
+	return min, max, err
+}
+
+// Act acts action by player of seat, and returns error if action is illegal.
+// Betting rounds, the board and showdown are advanced automatically.
+func (table *Table) Act(seat int, action Action) (err error) {
+	if table.Street == SHOWDOWN || seat != table.turn {
+		return fmt.Errorf("couldn't act, it is not turn of seat %d", seat)
+	}
+	player := table.Players[seat]
+	switch action.Type {
+	case FOLD:
+		player.Folded = true
+	case CHECK:
+		if player.Bet != table.current {
+			return errors.New("couldn't check, there is a bet to call")
+		}
+	case CALL:
+		if player.Bet == table.current {
+			return errors.New("couldn't call, there is no bet to call")
+		}
+		player.put(table.current - player.Bet)
+	case BET, RAISE:
+		if action.Type == BET && table.current > 0 {
+			return errors.New("couldn't bet, there is a bet to raise")
+		}
+		if action.Type == RAISE && table.current == 0 {
+			return errors.New("couldn't raise, there is no bet to raise")
+		}
+		min, max, err := table.RaiseRange()
+		if err != nil {
+			return err
+		}
+		if action.Amount < min || action.Amount > max {
+			return fmt.Errorf("couldn't %s, amount must be %d ~ %d", action.Type, min, max)
+		}
+		// All-in for less than a full raise doesn't reopen betting to players who have acted.
+		if raise := action.Amount - table.current; raise >= table.minRaise || table.current == 0 {
+			if raise > table.minRaise {
+				table.minRaise = raise
+			}
+			table.bets++
+			for _, other := range table.Players {
+				other.acted = false
+			}
+		}
+		table.current = action.Amount
+		player.put(action.Amount - player.Bet)
+	default:
+		return errors.New("couldn't act, action is unknown")
+	}
+	player.acted = true
+	table.advance()
+	return err
+}
+
+// advance passes turn to the next player, and moves to the next street or showdown if betting round is over.
+func (table *Table) advance() {
+	if table.count(func(p *Player) bool { return !p.Folded }) == 1 {
+		table.showdown()
+		return
+	}
+	// A player must act if the player hasn't acted yet or faces a bet,
+	// but the last player who can act doesn't need to act without a bet.
+	actives := table.count((*Player).active)
+	pending := func(p *Player) bool {
+		return p.active() && (p.Bet < table.current || !p.acted && actives > 1)
+	}
+	if table.count(pending) > 0 {
+		table.turn = table.next(table.turn, pending)
+		return
+	}
+	for table.Street < RIVER {
+		table.deal()
+		if actives > 1 {
+			table.turn = table.next(table.Button, (*Player).active)
+			return
+		}
+	}
+	table.showdown()
+}
+
+// deal moves to the next street, and deals burn card and the board.
+// StartHand ensures that the deck has enough cards for all streets.
+func (table *Table) deal() {
+	for _, player := range table.Players {
+		player.Bet, player.acted = 0, false
+	}
+	table.current, table.minRaise, table.bets = 0, table.BigBlind, 0
+	table.Street++
+	n := 1
+	if table.Street == FLOP {
+		n = 3
+	}
+	card, _ := table.Deck.Draw()
+	table.Burned = append(table.Burned, card)
+	for i := 0; i < n; i++ {
+		card, _ = table.Deck.Draw()
+		table.Board = append(table.Board, card)
+	}
+}
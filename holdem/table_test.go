@@ -0,0 +1,219 @@
+package holdem
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupTable(limit Limit, stacks ...int) (table *Table) {
+	table, _ = NewTable(Config{SmallBlind: 1, BigBlind: 2, Limit: limit}, stacks)
+	table.StartHand(gocard.NewDeck())
+	return table
+}
+
+// #################################
+// Test Table.StartHand()
+// #################################
+
+func TestStartHandHeadsUp(t *testing.T) {
+	table := setupTable(NOLIMIT, 100, 100)
+
+	if table.Players[0].Bet != 1 || table.Players[1].Bet != 2 || table.Turn() != 0 {
+		expected := "Button posts 1, other posts 2, Button acts first"
+		actual := []int{table.Players[0].Bet, table.Players[1].Bet, table.Turn()}
+		msg := "Blinds of heads-up are not posted correctly"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if len(table.Players[0].Hole) != 2 || len(table.Players[1].Hole) != 2 || len(table.Deck) != 48 {
+		expected := 48
+		actual := len(table.Deck)
+		msg := "Hole cards are not dealt"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestStartHandButton(t *testing.T) {
+	table := setupTable(NOLIMIT, 100, 0, 100, 100)
+	table.Act(table.Turn(), Action{Type: FOLD})
+	table.Act(table.Turn(), Action{Type: FOLD})
+
+	if err := table.StartHand(gocard.NewDeck()); err != nil || table.Button != 2 || !table.Players[1].Folded {
+		expected := 2
+		actual := table.Button
+		msg := "Expected button moves over player without chips, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestStartHandShortDeck(t *testing.T) {
+	table, _ := NewTable(Config{SmallBlind: 1, BigBlind: 2, Limit: NOLIMIT}, []int{100, 100})
+
+	if err := table.StartHand(gocard.NewDeck()[:11]); err == nil || table.Players[0].Stack != 100 || table.Players[1].Stack != 100 {
+		expected := "error and stacks are not changed"
+		actual := []int{table.Players[0].Stack, table.Players[1].Stack}
+		msg := "Couldn't catch error as starting hand with deck short of 12 cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := table.StartHand(gocard.NewDeck()[:12]); err != nil {
+		t.Fatalf("Couldn't start hand with deck of 12 cards\nError: %v", err)
+	}
+}
+
+// #################################
+// Test Table.Act()
+// #################################
+
+func TestActStreets(t *testing.T) {
+	table := setupTable(NOLIMIT, 100, 100)
+	table.Act(0, Action{Type: CALL})
+
+	if table.Street != PREFLOP || table.Turn() != 1 {
+		expected := 1
+		actual := table.Turn()
+		msg := "Expected big blind has option after call, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	table.Act(1, Action{Type: CHECK})
+	if table.Street != FLOP || len(table.Board) != 3 || len(table.Burned) != 1 || table.Turn() != 1 {
+		expected := "Flop, 3 cards, 1 burned, seat 1"
+		actual := []interface{}{table.Street, len(table.Board), len(table.Burned), table.Turn()}
+		msg := "Flop is not dealt after preflop"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := table.Act(1, Action{Type: RAISE, Amount: 4}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as raising without bet"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	table.Act(1, Action{Type: BET, Amount: 10})
+	table.Act(0, Action{Type: FOLD})
+	if !table.Done() || table.Winnings[1] != 14 || table.Players[1].Stack != 102 {
+		expected := 14
+		actual := table.Winnings
+		msg := "Expected the last player wins the pot, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestActNoLimitMinRaise(t *testing.T) {
+	table := setupTable(NOLIMIT, 100, 100, 100)
+
+	if err := table.Act(0, Action{Type: RAISE, Amount: 3}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as raising less than min raise"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	table.Act(0, Action{Type: RAISE, Amount: 10})
+	if min, max, _ := table.RaiseRange(); min != 18 || max != 100 {
+		expected := []int{18, 100}
+		actual := []int{min, max}
+		msg := "Range of re-raise is not expected range"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestActShortAllInDoesNotReopen(t *testing.T) {
+	table := setupTable(NOLIMIT, 100, 100, 13)
+	table.Act(0, Action{Type: CALL})
+	table.Act(1, Action{Type: CALL})
+	table.Act(2, Action{Type: CHECK})
+
+	table.Act(1, Action{Type: BET, Amount: 10})
+	if err := table.Act(2, Action{Type: RAISE, Amount: 11}); err != nil || !table.Players[2].AllIn {
+		t.Fatalf("Couldn't go all-in for less than a full raise\nError: %v", err)
+	}
+	if min, max, err := table.RaiseRange(); err != nil || min != 21 {
+		expected := 21
+		actual := min
+		msg := "Expected player who hasn't acted can re-raise, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v, %v)", msg, expected, actual, max, err)
+	}
+	table.Act(0, Action{Type: CALL})
+
+	if table.Turn() != 1 {
+		expected := 1
+		actual := table.Turn()
+		msg := "Expected player who bet faces all-in, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if _, _, err := table.RaiseRange(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as re-raising after all-in for less than a full raise"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := table.Act(1, Action{Type: CALL}); err != nil || table.Street != TURN {
+		expected := TURN
+		actual := table.Street
+		msg := "Expected player who bet can call all-in, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestActPotLimit(t *testing.T) {
+	table := setupTable(POTLIMIT, 100, 100, 100)
+
+	if min, max, _ := table.RaiseRange(); min != 4 || max != 7 {
+		expected := []int{4, 7}
+		actual := []int{min, max}
+		msg := "Range of pot-limit raise is not expected range"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestActFixedLimitCap(t *testing.T) {
+	table := setupTable(FIXEDLIMIT, 100, 100, 100)
+	for _, amount := range []int{4, 6, 8} {
+		if err := table.Act(table.Turn(), Action{Type: RAISE, Amount: amount}); err != nil {
+			t.Fatalf("Couldn't raise to %d\nError: %v", amount, err)
+		}
+	}
+
+	if err := table.Act(table.Turn(), Action{Type: RAISE, Amount: 10}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as raising over cap of fixed-limit"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Table.Pots()
+// #################################
+
+func TestPotsAllIn(t *testing.T) {
+	table := setupTable(NOLIMIT, 50, 100, 200)
+	table.Act(0, Action{Type: RAISE, Amount: 50})
+	table.Act(1, Action{Type: RAISE, Amount: 100})
+	table.Act(2, Action{Type: RAISE, Amount: 200})
+
+	pots := table.Pots()
+	expected := []Pot{{150, []int{0, 1, 2}}, {100, []int{1, 2}}, {100, []int{2}}}
+	if len(pots) != len(expected) {
+		actual := pots
+		msg := "Side pots are not built from all-in players"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	for i := range expected {
+		if pots[i].Amount != expected[i].Amount || !equalSeats(pots[i].Eligible, expected[i].Eligible) {
+			actual := pots
+			msg := "Side pots are not built from all-in players"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+
+	total := 0
+	for _, player := range table.Players {
+		total += player.Stack
+	}
+	if !table.Done() || len(table.Board) != 5 || total != 350 || table.Winnings[2] < 100 {
+		expected := 350
+		actual := total
+		msg := "Expected board is dealt out and all chips are awarded, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
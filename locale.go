@@ -0,0 +1,221 @@
+package card
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Locale is names of ranks and suits in a language.
+// Tag is language tag. (e.g. de)
+// Pattern is format of card name which has rank name as 1st and suit name as 2nd argument. (e.g. "%[2]s-%[1]s")
+// Symbols is short symbols of ranks. Missing symbols are A, 2 ~ 10, J, Q, K.
+type Locale struct {
+	Tag     string
+	Ranks   map[Rank]string
+	Suits   map[Suit]string
+	Symbols map[Rank]string
+	Pattern string
+}
+
+// suitSymbols is short symbols of suits which are common in all locales.
+var suitSymbols = map[Suit]string{
+	SPADES:   "♠",
+	HEARTS:   "♥",
+	DIAMONDS: "♦",
+	CLUBS:    "♣",
+}
+
+// englishRanks returns English names of ranks.
+func englishRanks() (names map[Rank]string) {
+	names = map[Rank]string{}
+	for rank := ACE; rank <= KING; rank++ {
+		names[rank] = rank.String()
+	}
+	return names
+}
+
+// englishSuits returns English names of suits.
+func englishSuits() (names map[Suit]string) {
+	names = map[Suit]string{}
+	for suit := SPADES; suit <= CLUBS; suit++ {
+		names[suit] = suit.String()
+	}
+	return names
+}
+
+// localesMu guards locales.
+var localesMu sync.RWMutex
+
+var locales = map[string]Locale{
+	"en": {
+		Tag:     "en",
+		Ranks:   englishRanks(),
+		Suits:   englishSuits(),
+		Pattern: "%s of %s",
+	},
+	"ja": {
+		Tag: "ja",
+		Ranks: map[Rank]string{
+			ACE: "エース", TWO: "2", THREE: "3", FOUR: "4", FIVE: "5", SIX: "6", SEVEN: "7",
+			EIGHT: "8", NINE: "9", TEN: "10", JACK: "ジャック", QUEEN: "クイーン", KING: "キング",
+		},
+		Suits:   map[Suit]string{SPADES: "スペード", HEARTS: "ハート", DIAMONDS: "ダイヤ", CLUBS: "クラブ"},
+		Pattern: "%[2]sの%[1]s",
+	},
+	"de": {
+		Tag: "de",
+		Ranks: map[Rank]string{
+			ACE: "Ass", TWO: "Zwei", THREE: "Drei", FOUR: "Vier", FIVE: "Fünf", SIX: "Sechs", SEVEN: "Sieben",
+			EIGHT: "Acht", NINE: "Neun", TEN: "Zehn", JACK: "Bube", QUEEN: "Dame", KING: "König",
+		},
+		Suits:   map[Suit]string{SPADES: "Pik", HEARTS: "Herz", DIAMONDS: "Karo", CLUBS: "Kreuz"},
+		Symbols: map[Rank]string{JACK: "B", QUEEN: "D", KING: "K"},
+		Pattern: "%[2]s-%[1]s",
+	},
+	"fr": {
+		Tag: "fr",
+		Ranks: map[Rank]string{
+			ACE: "As", TWO: "Deux", THREE: "Trois", FOUR: "Quatre", FIVE: "Cinq", SIX: "Six", SEVEN: "Sept",
+			EIGHT: "Huit", NINE: "Neuf", TEN: "Dix", JACK: "Valet", QUEEN: "Dame", KING: "Roi",
+		},
+		Suits:   map[Suit]string{SPADES: "Pique", HEARTS: "Cœur", DIAMONDS: "Carreau", CLUBS: "Trèfle"},
+		Symbols: map[Rank]string{JACK: "V", QUEEN: "D", KING: "R"},
+		Pattern: "%s de %s",
+	},
+	"es": {
+		Tag: "es",
+		Ranks: map[Rank]string{
+			ACE: "As", TWO: "Dos", THREE: "Tres", FOUR: "Cuatro", FIVE: "Cinco", SIX: "Seis", SEVEN: "Siete",
+			EIGHT: "Ocho", NINE: "Nueve", TEN: "Diez", JACK: "Jota", QUEEN: "Reina", KING: "Rey",
+		},
+		Suits:   map[Suit]string{SPADES: "Picas", HEARTS: "Corazones", DIAMONDS: "Diamantes", CLUBS: "Tréboles"},
+		Pattern: "%s de %s",
+	},
+}
+
+// clone returns copy of locale which doesn't share maps.
+func (locale Locale) clone() (copied Locale) {
+	copied = locale
+	copied.Ranks = map[Rank]string{}
+	for rank, name := range locale.Ranks {
+		copied.Ranks[rank] = name
+	}
+	copied.Suits = map[Suit]string{}
+	for suit, name := range locale.Suits {
+		copied.Suits[suit] = name
+	}
+	if locale.Symbols != nil {
+		copied.Symbols = map[Rank]string{}
+		for rank, symbol := range locale.Symbols {
+			copied.Symbols[rank] = symbol
+		}
+	}
+	return copied
+}
+
+// validPattern returns whether pattern formats a rank name and a suit name once each without errors.
+func validPattern(pattern string) (ok bool) {
+	rank, suit := "\x00rank\x00", "\x00suit\x00"
+	msg := fmt.Sprintf(pattern, rank, suit)
+	return strings.Count(msg, rank) == 1 && strings.Count(msg, suit) == 1 && !strings.Contains(msg, "%!")
+}
+
+// RegisterLocale registers copy of locale, and replaces registered locale which has same tag.
+// It returns error if locale has no tag, lacks names of some ranks or suits,
+// or Pattern doesn't have a rank name and a suit name.
+func RegisterLocale(locale Locale) (err error) {
+	if locale.Tag == "" {
+		err = errors.New("couldn't register locale, tag is empty")
+		return err
+	}
+	for rank := ACE; rank <= KING; rank++ {
+		if _, ok := locale.Ranks[rank]; !ok {
+			err = fmt.Errorf("couldn't register locale %q, name of %s is missing", locale.Tag, rank)
+			return err
+		}
+	}
+	for suit := SPADES; suit <= CLUBS; suit++ {
+		if _, ok := locale.Suits[suit]; !ok {
+			err = fmt.Errorf("couldn't register locale %q, name of %s is missing", locale.Tag, suit)
+			return err
+		}
+	}
+	if locale.Pattern == "" {
+		locale.Pattern = "%s of %s"
+	}
+	if !validPattern(locale.Pattern) {
+		err = fmt.Errorf("couldn't register locale %q, pattern %q must have a rank name and a suit name", locale.Tag, locale.Pattern)
+		return err
+	}
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[locale.Tag] = locale.clone()
+	return err
+}
+
+// LookupLocale returns copy of registered locale of tag, and returns error if it is not registered.
+// Registered locales are en, ja, de, fr and es by default.
+func LookupLocale(tag string) (locale Locale, err error) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	locale, ok := locales[tag]
+	if !ok {
+		err = fmt.Errorf("couldn't find locale %q", tag)
+		return locale, err
+	}
+	return locale.clone(), err
+}
+
+// Rank returns name of rank in the locale. (e.g. Dame)
+func (locale Locale) Rank(rank Rank) (msg string) {
+	if name, ok := locale.Ranks[rank]; ok {
+		return name
+	}
+	return rank.String()
+}
+
+// Suit returns name of suit in the locale. (e.g. Cœur)
+func (locale Locale) Suit(suit Suit) (msg string) {
+	if name, ok := locale.Suits[suit]; ok {
+		return name
+	}
+	return suit.String()
+}
+
+// Card returns name of card in the locale. (e.g. Dame de Cœur)
+func (locale Locale) Card(card Card) (msg string) {
+	return fmt.Sprintf(locale.Pattern, locale.Rank(card.Rank), locale.Suit(card.Suit))
+}
+
+// Symbol returns short symbol of rank in the locale. (e.g. D)
+func (locale Locale) Symbol(rank Rank) (msg string) {
+	if symbol, ok := locale.Symbols[rank]; ok {
+		return symbol
+	}
+	switch {
+	case rank == ACE:
+		return "A"
+	case rank >= TWO && rank <= TEN:
+		return strconv.Itoa(int(rank))
+	case rank == JACK:
+		return "J"
+	case rank == QUEEN:
+		return "Q"
+	case rank == KING:
+		return "K"
+	default:
+		return "?"
+	}
+}
+
+// Short returns short symbol of card in the locale. (e.g. D♥)
+func (locale Locale) Short(card Card) (msg string) {
+	suit, ok := suitSymbols[card.Suit]
+	if !ok {
+		suit = "?"
+	}
+	return locale.Symbol(card.Rank) + suit
+}
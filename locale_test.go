@@ -0,0 +1,114 @@
+package card
+
+import (
+	"testing"
+)
+
+// #################################
+// Test Locale.Card()
+// #################################
+
+func TestLocaleCard(t *testing.T) {
+	names := map[string]string{
+		"en": "Queen of Hearts",
+		"ja": "ハートのクイーン",
+		"de": "Herz-Dame",
+		"fr": "Dame de Cœur",
+		"es": "Reina de Corazones",
+	}
+	card := Card{Rank: QUEEN, Suit: HEARTS}
+	for tag, expected := range names {
+		locale, err := LookupLocale(tag)
+		if actual := locale.Card(card); err != nil || actual != expected {
+			msg := "Name of card is not expected name"
+			t.Fatalf("%s (%s)\nExpected: %v\nActual  : %v (%v)", msg, tag, expected, actual, err)
+		}
+	}
+}
+
+// #################################
+// Test Locale.Short()
+// #################################
+
+func TestLocaleShort(t *testing.T) {
+	locale, _ := LookupLocale("de")
+	cards := map[Card]string{
+		{Rank: JACK, Suit: CLUBS}:   "B♣",
+		{Rank: QUEEN, Suit: HEARTS}: "D♥",
+		{Rank: TEN, Suit: DIAMONDS}: "10♦",
+		{Rank: ACE, Suit: SPADES}:   "A♠",
+	}
+	for card, expected := range cards {
+		if actual := locale.Short(card); actual != expected {
+			msg := "Short symbol of card is not expected symbol"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test RegisterLocale()
+// #################################
+
+func TestRegisterLocale(t *testing.T) {
+	en, _ := LookupLocale("en")
+	pirate := Locale{Tag: "en-pirate", Ranks: map[Rank]string{}, Suits: en.Suits, Pattern: "%s o' %s"}
+	for rank, name := range en.Ranks {
+		pirate.Ranks[rank] = name
+	}
+	pirate.Ranks[KING] = "Cap'n"
+
+	if err := RegisterLocale(pirate); err != nil {
+		t.Fatalf("Couldn't register locale\nError: %v", err)
+	}
+	// Registry keeps copy of locale.
+	pirate.Ranks[KING] = "King"
+	locale, err := LookupLocale("en-pirate")
+	if expected, actual := "Cap'n o' Spades", locale.Card(Card{Rank: KING, Suit: SPADES}); err != nil || actual != expected {
+		msg := "Name of card in registered locale is not expected name"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestRegisterInvalidLocale(t *testing.T) {
+	if err := RegisterLocale(Locale{Tag: "xx", Ranks: map[Rank]string{ACE: "A"}}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as registering locale without names of all ranks"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if _, err := LookupLocale("xx"); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as looking up unregistered locale"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestRegisterLocaleInvalidPattern(t *testing.T) {
+	en, _ := LookupLocale("en")
+	for _, pattern := range []string{"%s", "%s of %s %s", "%[2]s", "%d of %s"} {
+		locale := en
+		locale.Tag, locale.Pattern = "en-invalid", pattern
+		if err := RegisterLocale(locale); err == nil {
+			expected := "error"
+			actual := err
+			msg := "Couldn't catch error as registering locale with invalid pattern"
+			t.Fatalf("%s (%q)\nExpected: %v\nActual  : %v", msg, pattern, expected, actual)
+		}
+	}
+}
+
+func TestLookupLocaleCopy(t *testing.T) {
+	locale, _ := LookupLocale("fr")
+	locale.Ranks[QUEEN] = "Reine"
+	locale.Suits[HEARTS] = "Coeur"
+	locale.Symbols[QUEEN] = "R"
+
+	if fr, _ := LookupLocale("fr"); fr.Card(Card{Rank: QUEEN, Suit: HEARTS}) != "Dame de Cœur" || fr.Symbol(QUEEN) != "D" {
+		expected := "Dame de Cœur"
+		actual := fr.Card(Card{Rank: QUEEN, Suit: HEARTS})
+		msg := "Expected changes of looked-up locale don't change registry, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
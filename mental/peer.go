@@ -0,0 +1,184 @@
+/*
+Package mental implements mental poker, dealing cards without a trusted dealer.
+
+It uses SRA commutative encryption modulo a shared prime.
+Each peer encrypts and shuffles the deck, and then locks each card with its own key,
+so a card is revealed only to players who receive keys of the card from all other peers.
+Peers commit to their keys and permutations before dealing, and reveal them after the game
+so that anyone can verify the deck was shuffled and dealt fairly.
+*/
+package mental
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// DefaultPrime is the 2048-bit safe prime of RFC 3526 (MODP Group 14).
+var DefaultPrime, _ = new(big.Int).SetString(
+	"FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74020BBEA63B139B22514A08798E3404DD"+
+		"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED"+
+		"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3DC2007CB8A163BF0598DA48361C55D39A69163FA8FD24CF5F"+
+		"83655D23DCA3AD961C62F356208552BB9ED529077096966D670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B"+
+		"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9DE2BCBF6955817183995497CEA956AE515D2261898FA0510"+
+		"15728E5A8AACAA68FFFFFFFFFFFFFFFF", 16)
+
+// Secrets is secrets of a peer revealed after the game for verification.
+// ShuffleKey and CardKeys are encryption exponents, and Permutation is the shuffle of the peer.
+type Secrets struct {
+	ShuffleKey  *big.Int
+	CardKeys    []*big.Int
+	Permutation []int
+}
+
+// Commitment returns SHA-256 hash of secrets.
+func (secrets Secrets) Commitment() (hash [32]byte) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%x|", secrets.ShuffleKey)
+	for _, key := range secrets.CardKeys {
+		fmt.Fprintf(h, "%x|", key)
+	}
+	fmt.Fprint(h, secrets.Permutation)
+	copy(hash[:], h.Sum(nil))
+	return hash
+}
+
+// Peer is a participant of mental poker.
+type Peer struct {
+	prime   *big.Int
+	secrets Secrets
+}
+
+// NewPeer returns peer for deck of size cards with keys and permutation generated from random.
+// It uses crypto/rand if random is nil.
+func NewPeer(prime *big.Int, size int, random io.Reader) (peer *Peer, err error) {
+	if random == nil {
+		random = rand.Reader
+	}
+	peer = &Peer{prime: prime}
+	if peer.secrets.ShuffleKey, err = newKey(prime, random); err != nil {
+		return peer, err
+	}
+	for i := 0; i < size; i++ {
+		key, err := newKey(prime, random)
+		if err != nil {
+			return peer, err
+		}
+		peer.secrets.CardKeys = append(peer.secrets.CardKeys, key)
+	}
+	if peer.secrets.Permutation, err = newPermutation(size, random); err != nil {
+		return peer, err
+	}
+	return peer, err
+}
+
+// newKey returns random encryption exponent which is coprime to prime - 1.
+func newKey(prime *big.Int, random io.Reader) (key *big.Int, err error) {
+	order := new(big.Int).Sub(prime, big.NewInt(1))
+	for {
+		if key, err = rand.Int(random, order); err != nil {
+			return key, err
+		}
+		if key.Cmp(big.NewInt(2)) > 0 && new(big.Int).GCD(nil, nil, key, order).Cmp(big.NewInt(1)) == 0 {
+			return key, err
+		}
+	}
+}
+
+// newPermutation returns random permutation of size by Fisher-Yates shuffle.
+func newPermutation(size int, random io.Reader) (permutation []int, err error) {
+	permutation = make([]int, size)
+	for i := range permutation {
+		permutation[i] = i
+	}
+	for i := size - 1; i > 0; i-- {
+		j, err := rand.Int(random, big.NewInt(int64(i+1)))
+		if err != nil {
+			return permutation, err
+		}
+		permutation[i], permutation[j.Int64()] = permutation[j.Int64()], permutation[i]
+	}
+	return permutation, err
+}
+
+// Commitment returns commitment of secrets of peer, which is published before dealing.
+func (peer *Peer) Commitment() (hash [32]byte) {
+	return peer.secrets.Commitment()
+}
+
+// Reveal returns secrets of peer, which is published after the game.
+func (peer *Peer) Reveal() (secrets Secrets) {
+	return peer.secrets
+}
+
+// encrypt returns m^key mod prime.
+func encrypt(prime *big.Int, m *big.Int, key *big.Int) (c *big.Int) {
+	return new(big.Int).Exp(m, key, prime)
+}
+
+// decrypt returns c^(key^-1 mod prime-1) mod prime.
+func decrypt(prime *big.Int, c *big.Int, key *big.Int) (m *big.Int) {
+	inverse := new(big.Int).ModInverse(key, new(big.Int).Sub(prime, big.NewInt(1)))
+	return new(big.Int).Exp(c, inverse, prime)
+}
+
+// shuffle returns deck encrypted by the shuffle key and permuted by permutation.
+func shuffle(prime *big.Int, deck []*big.Int, secrets Secrets) (shuffled []*big.Int, err error) {
+	if len(deck) != len(secrets.Permutation) {
+		err = errors.New("couldn't shuffle, size of deck is different from size of keys")
+		return shuffled, err
+	}
+	shuffled = make([]*big.Int, len(deck))
+	for i, j := range secrets.Permutation {
+		shuffled[i] = encrypt(prime, deck[j], secrets.ShuffleKey)
+	}
+	return shuffled, err
+}
+
+// lock returns deck which shuffle key is removed and each card is encrypted by the card key.
+func lock(prime *big.Int, deck []*big.Int, secrets Secrets) (locked []*big.Int, err error) {
+	if len(deck) != len(secrets.CardKeys) {
+		err = errors.New("couldn't lock, size of deck is different from size of keys")
+		return locked, err
+	}
+	for i, c := range deck {
+		locked = append(locked, encrypt(prime, decrypt(prime, c, secrets.ShuffleKey), secrets.CardKeys[i]))
+	}
+	return locked, err
+}
+
+// Shuffle encrypts every card of deck with the shuffle key and shuffles it.
+func (peer *Peer) Shuffle(deck []*big.Int) (shuffled []*big.Int, err error) {
+	return shuffle(peer.prime, deck, peer.secrets)
+}
+
+// Lock removes the shuffle key from every card of shuffled deck and encrypts each card with its card key.
+func (peer *Peer) Lock(deck []*big.Int) (locked []*big.Int, err error) {
+	return lock(peer.prime, deck, peer.secrets)
+}
+
+// CardKey returns key of card at index, which is given to players to reveal the card.
+func (peer *Peer) CardKey(index int) (key *big.Int, err error) {
+	if index < 0 || index >= len(peer.secrets.CardKeys) {
+		err = fmt.Errorf("couldn't give key, index %d is out of deck", index)
+		return key, err
+	}
+	return peer.secrets.CardKeys[index], err
+}
+
+// Unlock returns c decrypted with own key of card at index and keys of the card given by other peers.
+func (peer *Peer) Unlock(index int, c *big.Int, keys []*big.Int) (m *big.Int, err error) {
+	own, err := peer.CardKey(index)
+	if err != nil {
+		return m, err
+	}
+	m = decrypt(peer.prime, c, own)
+	for _, key := range keys {
+		m = decrypt(peer.prime, m, key)
+	}
+	return m, err
+}
@@ -0,0 +1,58 @@
+package mental
+
+import (
+	"math/big"
+	"testing"
+)
+
+// #################################
+// Test encrypt(), decrypt()
+// #################################
+
+func TestCommutative(t *testing.T) {
+	peer1, _ := NewPeer(testPrime, 1, nil)
+	peer2, _ := NewPeer(testPrime, 1, nil)
+	m := encode(testPrime, 10)
+	key1, key2 := peer1.Reveal().ShuffleKey, peer2.Reveal().ShuffleKey
+
+	c := encrypt(testPrime, encrypt(testPrime, m, key1), key2)
+	if decrypt(testPrime, decrypt(testPrime, c, key1), key2).Cmp(m) != 0 {
+		expected := m
+		actual := c
+		msg := "Expected encryption is commutative, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Peer.CardKey()
+// #################################
+
+func TestCardKeyOutOfDeck(t *testing.T) {
+	peer, _ := NewPeer(testPrime, 2, nil)
+	if _, err := peer.CardKey(2); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as giving key out of deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if _, err := peer.Shuffle([]*big.Int{big.NewInt(4)}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as shuffling deck of different size"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test DefaultPrime
+// #################################
+
+func TestDefaultPrime(t *testing.T) {
+	if DefaultPrime == nil || DefaultPrime.BitLen() != 2048 || !DefaultPrime.ProbablyPrime(20) {
+		expected := "2048-bit prime"
+		actual := DefaultPrime
+		msg := "DefaultPrime is not 2048-bit prime"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,165 @@
+package mental
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Session is a deal of mental poker among peers simulated in process.
+// Stages are the deck after encoding and after each step of peers (shuffles then locks), which are public.
+type Session struct {
+	Prime       *big.Int
+	Deck        gocard.Deck
+	Peers       []*Peer
+	Commitments [][32]byte
+	Stages      [][]*big.Int
+}
+
+// encode returns message of card at index of deck. Messages are quadratic residues to hide no information.
+func encode(prime *big.Int, index int) (m *big.Int) {
+	m = big.NewInt(int64(index + 2))
+	return m.Mul(m, m).Mod(m, prime)
+}
+
+// NewSession returns session of deck among peers peers with keys generated from random, and
+// publishes commitments of peers. It uses crypto/rand if random is nil.
+func NewSession(deck gocard.Deck, peers int, prime *big.Int, random io.Reader) (session *Session, err error) {
+	if peers < 2 {
+		err = errors.New("couldn't start session, it needs 2 or more peers")
+		return session, err
+	}
+	session = &Session{Prime: prime, Deck: append(gocard.Deck{}, deck...)}
+	for i := 0; i < peers; i++ {
+		peer, err := NewPeer(prime, len(deck), random)
+		if err != nil {
+			return session, err
+		}
+		session.Peers = append(session.Peers, peer)
+		session.Commitments = append(session.Commitments, peer.Commitment())
+	}
+	encoded := make([]*big.Int, len(deck))
+	for i := range deck {
+		encoded[i] = encode(prime, i)
+	}
+	session.Stages = [][]*big.Int{encoded}
+	return session, err
+}
+
+// Shuffle lets each peer shuffle the deck in turn, and then lets each peer lock the deck in turn.
+func (session *Session) Shuffle() (err error) {
+	if len(session.Stages) != 1 {
+		return errors.New("couldn't shuffle, deck is already shuffled")
+	}
+	steps := []func(peer *Peer, deck []*big.Int) ([]*big.Int, error){(*Peer).Shuffle, (*Peer).Lock}
+	for _, step := range steps {
+		for _, peer := range session.Peers {
+			deck, err := step(peer, session.Stages[len(session.Stages)-1])
+			if err != nil {
+				return err
+			}
+			session.Stages = append(session.Stages, deck)
+		}
+	}
+	return err
+}
+
+// decode returns card of message m.
+func (session *Session) decode(m *big.Int) (card gocard.Card, err error) {
+	for i := range session.Deck {
+		if encode(session.Prime, i).Cmp(m) == 0 {
+			return session.Deck[i], err
+		}
+	}
+	err = errors.New("couldn't decode, message is not a card")
+	return card, err
+}
+
+// Open reveals card at index of the shuffled deck to peer, and returns error if it couldn't be revealed.
+// All other peers give their keys of the card only to peer.
+func (session *Session) Open(index int, peer int) (card gocard.Card, err error) {
+	if len(session.Stages) != 1+2*len(session.Peers) {
+		err = errors.New("couldn't open, deck is not shuffled")
+		return card, err
+	}
+	if peer < 0 || peer >= len(session.Peers) {
+		err = fmt.Errorf("couldn't open, peer %d doesn't exist", peer)
+		return card, err
+	}
+	deck := session.Stages[len(session.Stages)-1]
+	if index < 0 || index >= len(deck) {
+		err = fmt.Errorf("couldn't open, index %d is out of deck", index)
+		return card, err
+	}
+	var keys []*big.Int
+	for i, other := range session.Peers {
+		if i == peer {
+			continue
+		}
+		key, err := other.CardKey(index)
+		if err != nil {
+			return card, err
+		}
+		keys = append(keys, key)
+	}
+	m, err := session.Peers[peer].Unlock(index, deck[index], keys)
+	if err != nil {
+		return card, err
+	}
+	return session.decode(m)
+}
+
+// Verify verifies stages of session with secrets revealed by peers after the game.
+// It returns error if secrets differ from commitments or any stage was not computed from secrets.
+func Verify(prime *big.Int, commitments [][32]byte, stages [][]*big.Int, secrets []Secrets) (err error) {
+	if len(secrets) != len(commitments) || len(stages) != 1+2*len(secrets) {
+		return errors.New("couldn't verify, numbers of peers and stages don't match")
+	}
+	for i, s := range secrets {
+		if hash := s.Commitment(); !bytes.Equal(hash[:], commitments[i][:]) {
+			return fmt.Errorf("couldn't verify, secrets of peer %d differ from commitment", i)
+		}
+	}
+	for i := range stages[0] {
+		if encode(prime, i).Cmp(stages[0][i]) != 0 {
+			return errors.New("couldn't verify, deck is not encoded correctly")
+		}
+	}
+	for step := 1; step < len(stages); step++ {
+		peer := (step - 1) % len(secrets)
+		compute := shuffle
+		if step > len(secrets) {
+			compute = lock
+		}
+		expected, err := compute(prime, stages[step-1], secrets[peer])
+		if err != nil {
+			return err
+		}
+		if len(expected) != len(stages[step]) {
+			return fmt.Errorf("couldn't verify, stage %d has wrong size", step)
+		}
+		for i := range expected {
+			if expected[i].Cmp(stages[step][i]) != 0 {
+				return fmt.Errorf("couldn't verify, stage %d by peer %d is not computed from secrets", step, peer)
+			}
+		}
+	}
+	return err
+}
+
+// Order returns the final order of deck computed from secrets of peers. It is used to check dealt cards after the game.
+func Order(deck gocard.Deck, secrets []Secrets) (order gocard.Deck) {
+	order = append(gocard.Deck{}, deck...)
+	for _, s := range secrets {
+		shuffled := make(gocard.Deck, len(order))
+		for i, j := range s.Permutation {
+			shuffled[i] = order[j]
+		}
+		order = shuffled
+	}
+	return order
+}
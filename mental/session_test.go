@@ -0,0 +1,107 @@
+package mental
+
+import (
+	"math/big"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+
+// testPrime is the 1024-bit safe prime of RFC 2409 (Oakley Group 2), which is smaller for fast tests.
+var testPrime, _ = new(big.Int).SetString(
+	"FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74020BBEA63B139B22514A08798E3404DD"+
+		"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED"+
+		"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE65381FFFFFFFFFFFFFFFF", 16)
+
+func setupSession(t *testing.T) (session *Session) {
+	session, err := NewSession(gocard.NewDeck(), 3, testPrime, nil)
+	if err != nil {
+		t.Fatalf("Couldn't start session\nError: %v", err)
+	}
+	if err := session.Shuffle(); err != nil {
+		t.Fatalf("Couldn't shuffle deck\nError: %v", err)
+	}
+	return session
+}
+
+func setupSecrets(session *Session) (secrets []Secrets) {
+	for _, peer := range session.Peers {
+		secrets = append(secrets, peer.Reveal())
+	}
+	return secrets
+}
+
+// #################################
+// Test Session.Open()
+// #################################
+
+func TestOpen(t *testing.T) {
+	session := setupSession(t)
+	order := Order(session.Deck, setupSecrets(session))
+
+	seen := map[gocard.Card]bool{}
+	for i := 0; i < 5; i++ {
+		card, err := session.Open(i, i%3)
+		if err != nil || card != order[i] || seen[card] {
+			expected := order[i]
+			actual := card
+			msg := "Opened card is not card in shuffled order"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+		}
+		seen[card] = true
+	}
+}
+
+func TestOpenWithoutKeys(t *testing.T) {
+	session := setupSession(t)
+	deck := session.Stages[len(session.Stages)-1]
+
+	// Peer 0 tries to open card without key of peer 2
+	key, _ := session.Peers[1].CardKey(0)
+	m, _ := session.Peers[0].Unlock(0, deck[0], []*big.Int{key})
+	if _, err := session.decode(m); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Expected card couldn't be opened without keys of all peers, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Verify()
+// #################################
+
+func TestVerify(t *testing.T) {
+	session := setupSession(t)
+	secrets := setupSecrets(session)
+
+	if err := Verify(session.Prime, session.Commitments, session.Stages, secrets); err != nil {
+		t.Fatalf("Couldn't verify fair session\nError: %v", err)
+	}
+
+	// Peer 1 reveals different permutation
+	cheat := secrets[1]
+	cheat.Permutation = append([]int{}, cheat.Permutation...)
+	cheat.Permutation[0], cheat.Permutation[1] = cheat.Permutation[1], cheat.Permutation[0]
+	secrets[1] = cheat
+	if err := Verify(session.Prime, session.Commitments, session.Stages, secrets); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as verifying secrets different from commitment"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestVerifyTamperedStage(t *testing.T) {
+	session := setupSession(t)
+	session.Stages[2][0], session.Stages[2][1] = session.Stages[2][1], session.Stages[2][0]
+
+	if err := Verify(session.Prime, session.Commitments, session.Stages, setupSecrets(session)); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as verifying tampered stage"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,66 @@
+package card
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseCard returns card of short notation, and returns error if s is not a card.
+// Notation is rank (A, 2 ~ 10, T, J, Q, K) followed by suit (s, h, d, c or ♠, ♥, ♦, ♣). (e.g. As, 10h, Td, Q♣)
+// Letters are case-insensitive.
+func ParseCard(s string) (card Card, err error) {
+	runes := []rune(strings.ToUpper(strings.TrimSpace(s)))
+	if len(runes) < 2 {
+		err = fmt.Errorf("couldn't parse %q, it is not a card", s)
+		return card, err
+	}
+	rank, suit := string(runes[:len(runes)-1]), runes[len(runes)-1]
+
+	switch rank {
+	case "A", "1":
+		card.Rank = ACE
+	case "T", "10":
+		card.Rank = TEN
+	case "J":
+		card.Rank = JACK
+	case "Q":
+		card.Rank = QUEEN
+	case "K":
+		card.Rank = KING
+	default:
+		if len(rank) == 1 && rank[0] >= '2' && rank[0] <= '9' {
+			card.Rank = Rank(rank[0] - '0')
+		}
+	}
+	switch suit {
+	case 'S', '♠':
+		card.Suit = SPADES
+	case 'H', '♥':
+		card.Suit = HEARTS
+	case 'D', '♦':
+		card.Suit = DIAMONDS
+	case 'C', '♣':
+		card.Suit = CLUBS
+	}
+
+	if card.Rank == 0 || card.Suit == 0 {
+		err = fmt.Errorf("couldn't parse %q, it is not a card", s)
+		return Card{}, err
+	}
+	return card, err
+}
+
+// ParseCards returns cards of short notations separated by spaces or commas. (e.g. "As Kd, 10h")
+func ParseCards(s string) (cards Cards, err error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+	for _, field := range fields {
+		card, err := ParseCard(field)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+	return cards, err
+}
@@ -0,0 +1,57 @@
+package card
+
+import (
+	"testing"
+)
+
+// #################################
+// Test ParseCard()
+// #################################
+
+func TestParseCard(t *testing.T) {
+	notations := map[string]Card{
+		"As":  {Rank: ACE, Suit: SPADES},
+		"10h": {Rank: TEN, Suit: HEARTS},
+		"td":  {Rank: TEN, Suit: DIAMONDS},
+		"Q♣":  {Rank: QUEEN, Suit: CLUBS},
+		"7C":  {Rank: SEVEN, Suit: CLUBS},
+	}
+	for notation, expected := range notations {
+		if card, err := ParseCard(notation); err != nil || card != expected {
+			actual := card
+			msg := "Parsed card is not expected card"
+			t.Fatalf("%s (%s)\nExpected: %v\nActual  : %v (%v)", msg, notation, expected, actual, err)
+		}
+	}
+}
+
+func TestParseInvalidCard(t *testing.T) {
+	for _, notation := range []string{"", "A", "1x", "11s", "Zh"} {
+		if _, err := ParseCard(notation); err == nil {
+			expected := "error"
+			actual := err
+			msg := "Couldn't catch error as parsing invalid notation"
+			t.Fatalf("%s (%q)\nExpected: %v\nActual  : %v", msg, notation, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test ParseCards()
+// #################################
+
+func TestParseCards(t *testing.T) {
+	cards, err := ParseCards("As Kd, 6h")
+	expected := Cards{{Rank: ACE, Suit: SPADES}, {Rank: KING, Suit: DIAMONDS}, {Rank: SIX, Suit: HEARTS}}
+	if err != nil || len(cards) != 3 || cards[2] != expected[2] {
+		actual := cards
+		msg := "Parsed cards are not expected cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if _, err := ParseCards("As Kx"); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as parsing invalid notation"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,148 @@
+package card
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// These constant values are names of the draw pile and the discard pile of Piles.
+const (
+	DRAWPILE    = "draw"
+	DISCARDPILE = "discard"
+)
+
+// Reshuffle is policy to refill the draw pile when it is empty. (NORESHUFFLE, RESHUFFLE, KEEPTOP)
+type Reshuffle int
+
+// These constant values are policies of reshuffle.
+// RESHUFFLE shuffles all cards of the discard pile into the draw pile,
+// and KEEPTOP does it except the top card of the discard pile.
+const (
+	NORESHUFFLE Reshuffle = iota + 1
+	RESHUFFLE
+	KEEPTOP
+)
+
+// String returns string of policy of reshuffle. (e.g. Reshuffle)
+func (policy Reshuffle) String() (msg string) {
+	switch policy {
+	case NORESHUFFLE:
+		return "No reshuffle"
+	case RESHUFFLE:
+		return "Reshuffle"
+	case KEEPTOP:
+		return "Keep top"
+	default:
+		return "Unknown"
+	}
+}
+
+// Piles is named piles of cards on a table. Index 0 of each pile is the top.
+// Policy is used when Draw finds the draw pile empty, and Rand is random source of reshuffle if it is not nil.
+// Zero value of Piles has no piles and is ready to use.
+type Piles struct {
+	Piles  map[string]Deck
+	Policy Reshuffle
+	Rand   *rand.Rand
+}
+
+// NewPiles returns piles which draw pile is copy of deck and discard pile is empty.
+func NewPiles(deck Deck, policy Reshuffle) (piles *Piles) {
+	return &Piles{
+		Piles:  map[string]Deck{DRAWPILE: append(Deck{}, deck...), DISCARDPILE: {}},
+		Policy: policy,
+	}
+}
+
+// Len returns number of cards in pile of name. Pile which doesn't exist has no cards.
+func (piles *Piles) Len(name string) (length int) {
+	return len(piles.Piles[name])
+}
+
+// Peek returns the top card of pile of name without removing it, and returns error if the pile is empty.
+func (piles *Piles) Peek(name string) (card Card, err error) {
+	pile := piles.Piles[name]
+	if len(pile) == 0 {
+		err = fmt.Errorf("couldn't peek, pile %q is empty", name)
+		return card, err
+	}
+	return pile[0], err
+}
+
+// set sets pile of name. Piles is made if it is nil, so zero value of Piles is usable.
+func (piles *Piles) set(name string, pile Deck) {
+	if piles.Piles == nil {
+		piles.Piles = map[string]Deck{}
+	}
+	piles.Piles[name] = pile
+}
+
+// Put puts cards on the top of pile of name. The first card of cards is the top.
+// The pile is created if it doesn't exist.
+func (piles *Piles) Put(name string, cards ...Card) {
+	piles.set(name, append(append(Deck{}, cards...), piles.Piles[name]...))
+}
+
+// Take removes n cards from the top of pile of name and returns them.
+// It returns error if the pile has less than n cards.
+func (piles *Piles) Take(name string, n int) (cards Cards, err error) {
+	pile := piles.Piles[name]
+	if n < 0 || n > len(pile) {
+		err = fmt.Errorf("couldn't take %d cards, pile %q has %d cards", n, name, len(pile))
+		return cards, err
+	}
+	if n == 0 {
+		return cards, err
+	}
+	cards = append(Cards{}, pile[:n]...)
+	piles.set(name, pile[n:])
+	return cards, err
+}
+
+// Move moves n cards from the top of pile from to the top of pile to keeping their order.
+func (piles *Piles) Move(from string, to string, n int) (err error) {
+	cards, err := piles.Take(from, n)
+	if err != nil {
+		return err
+	}
+	piles.Put(to, cards...)
+	return err
+}
+
+// Discard puts a card on the top of the discard pile.
+func (piles *Piles) Discard(card Card) {
+	piles.Put(DISCARDPILE, card)
+}
+
+// Draw draws card from the top of the draw pile.
+// If the draw pile is empty, it is refilled from the discard pile by Policy before drawing.
+// It returns error if no cards can be drawn.
+func (piles *Piles) Draw() (card Card, err error) {
+	if piles.Len(DRAWPILE) == 0 && (piles.Policy == RESHUFFLE || piles.Policy == KEEPTOP) {
+		piles.Reshuffle()
+	}
+	if piles.Len(DRAWPILE) == 0 {
+		err = fmt.Errorf("couldn't draw, pile %q is empty", DRAWPILE)
+		return card, err
+	}
+	cards, err := piles.Take(DRAWPILE, 1)
+	return cards[0], err
+}
+
+// Reshuffle shuffles cards of the discard pile and puts them on the bottom of the draw pile.
+// The top card of the discard pile is kept if Policy is KEEPTOP, otherwise all cards are reshuffled.
+func (piles *Piles) Reshuffle() {
+	keep := 0
+	if piles.Policy == KEEPTOP && piles.Len(DISCARDPILE) > 0 {
+		keep = 1
+	}
+	discards := piles.Piles[DISCARDPILE]
+	cards := append(Deck{}, discards[keep:]...)
+	if piles.Rand != nil {
+		cards.ShuffleWith(piles.Rand)
+	} else {
+		cards.Shuffle()
+	}
+	piles.set(DISCARDPILE, append(Deck{}, discards[:keep]...))
+	piles.set(DRAWPILE, append(piles.Piles[DRAWPILE], cards...))
+}
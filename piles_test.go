@@ -0,0 +1,136 @@
+package card
+
+import (
+	"testing"
+)
+
+// Setup for test
+func setupPiles(policy Reshuffle) (piles *Piles) {
+	piles = NewPiles(Deck{{Rank: ACE, Suit: SPADES}}, policy)
+	piles.Discard(Card{Rank: TWO, Suit: HEARTS})
+	piles.Discard(Card{Rank: THREE, Suit: HEARTS})
+	return piles
+}
+
+// #################################
+// Test Piles.Draw()
+// #################################
+
+func TestPilesDrawReshuffle(t *testing.T) {
+	piles := setupPiles(RESHUFFLE)
+	piles.Draw()
+
+	if _, err := piles.Draw(); err != nil || piles.Len(DRAWPILE) != 1 || piles.Len(DISCARDPILE) != 0 {
+		expected := "1 card in draw pile and no cards in discard pile"
+		actual := piles.Piles
+		msg := "Expected discard pile is reshuffled into draw pile, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestPilesDrawKeepTop(t *testing.T) {
+	piles := setupPiles(KEEPTOP)
+	piles.Draw()
+
+	card, err := piles.Draw()
+	if err != nil || card != (Card{Rank: TWO, Suit: HEARTS}) {
+		expected := Card{Rank: TWO, Suit: HEARTS}
+		actual := card
+		msg := "Expected cards under the top of discard pile are reshuffled, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if top, err := piles.Peek(DISCARDPILE); err != nil || top != (Card{Rank: THREE, Suit: HEARTS}) {
+		expected := Card{Rank: THREE, Suit: HEARTS}
+		actual := top
+		msg := "Expected the top of discard pile is kept, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if _, err := piles.Draw(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as drawing when only the top of discard pile remains"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestPilesDrawNoReshuffle(t *testing.T) {
+	piles := setupPiles(NORESHUFFLE)
+	piles.Draw()
+
+	if _, err := piles.Draw(); err == nil || piles.Len(DISCARDPILE) != 2 {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as drawing from empty draw pile without reshuffle"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestNewPilesCopiesDeck(t *testing.T) {
+	// Spare capacity of deck must not be written by piles.
+	deck := append(make(Deck, 0, 8), Card{Rank: ACE, Suit: SPADES}, Card{Rank: TWO, Suit: SPADES})
+	piles := NewPiles(deck, RESHUFFLE)
+	for i := 0; i < 4; i++ {
+		// Drawing from empty draw pile appends reshuffled cards to it.
+		card, _ := piles.Draw()
+		piles.Discard(card)
+	}
+
+	full := deck[:cap(deck)]
+	if deck[0] != (Card{Rank: ACE, Suit: SPADES}) || deck[1] != (Card{Rank: TWO, Suit: SPADES}) || full[2] != (Card{}) {
+		expected := Deck{{Rank: ACE, Suit: SPADES}, {Rank: TWO, Suit: SPADES}, {}}
+		actual := full[:3]
+		msg := "Expected deck of caller is not changed by piles, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Piles.Move()
+// #################################
+
+func TestPilesMove(t *testing.T) {
+	piles := setupPiles(NORESHUFFLE)
+
+	if err := piles.Move(DISCARDPILE, "foundation", 2); err != nil || piles.Len("foundation") != 2 {
+		expected := 2
+		actual := piles.Len("foundation")
+		msg := "Expected 2 cards are moved to new pile, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if top, _ := piles.Peek("foundation"); top != (Card{Rank: THREE, Suit: HEARTS}) {
+		expected := Card{Rank: THREE, Suit: HEARTS}
+		actual := top
+		msg := "Expected order of moved cards is kept, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := piles.Move(DISCARDPILE, "foundation", 1); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as moving cards from empty pile"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestPilesZeroValue(t *testing.T) {
+	piles := &Piles{Policy: RESHUFFLE}
+	if _, err := piles.Take("foundation", 0); err != nil || piles.Piles != nil {
+		expected := "no piles are created"
+		actual := piles.Piles
+		msg := "Expected taking no cards doesn't change piles, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if _, err := piles.Draw(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as drawing from zero value of piles"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+
+	piles.Discard(Card{Rank: ACE, Suit: SPADES})
+	if card, err := piles.Draw(); err != nil || card != (Card{Rank: ACE, Suit: SPADES}) {
+		expected := Card{Rank: ACE, Suit: SPADES}
+		actual := card
+		msg := "Expected discard pile is reshuffled into draw pile, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Package replay records deck operations and player actions of a game, and replays them deterministically.
+
+Recorder shuffles the deck with a random source made from the seed of the log,
+so replaying the log shuffles the deck in same order.
+Actions and the start state of a game are encoded to the log by a StateCodec, and a Replayer made by
+NewGameReplayer decodes them and applies actions to the start state with game.Rules,
+so the state at any step can be rebuilt from the log alone.
+*/
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+
+	gocard "github.com/x-color/gocard"
+	"github.com/x-color/gocard/game"
+)
+
+// Op is type of an entry of log. (SHUFFLE, DRAW, PUTTOP, PUTBOTTOM, ACTION)
+type Op string
+
+// These constant values are types of entries.
+const (
+	SHUFFLE   Op = "shuffle"
+	DRAW      Op = "draw"
+	PUTTOP    Op = "puttop"
+	PUTBOTTOM Op = "putbottom"
+	ACTION    Op = "action"
+)
+
+// Entry is a deck operation or a player action.
+// Card is drawn or put card, and Seat and Action are a player action.
+type Entry struct {
+	Op     Op           `json:"op"`
+	Card   *gocard.Card `json:"card,omitempty"`
+	Seat   int          `json:"seat,omitempty"`
+	Action string       `json:"action,omitempty"`
+}
+
+// String returns string of entry. (e.g. draw Ace of Spades)
+func (entry Entry) String() (msg string) {
+	switch {
+	case entry.Op == ACTION:
+		return fmt.Sprintf("%s seat %d %s", entry.Op, entry.Seat, entry.Action)
+	case entry.Card != nil:
+		return fmt.Sprintf("%s %s", entry.Op, entry.Card)
+	default:
+		return string(entry.Op)
+	}
+}
+
+// Log is a log of a game. Deck is the deck at the start, and Seed is seed of random source of shuffles.
+// Start is the start state of the game encoded by StateCodec, and it is empty if the log has no game.
+type Log struct {
+	Seed    int64       `json:"seed"`
+	Deck    gocard.Deck `json:"deck"`
+	Start   string      `json:"start,omitempty"`
+	Entries []Entry     `json:"entries"`
+}
+
+// WriteLog writes log as JSON to w.
+func WriteLog(w io.Writer, log Log) (err error) {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+// ReadLog reads log of JSON from r, and returns error of invalid JSON.
+func ReadLog(r io.Reader) (log Log, err error) {
+	err = json.NewDecoder(r).Decode(&log)
+	return log, err
+}
+
+// Codec encodes actions of a game to strings of log, and decodes them.
+type Codec interface {
+	Encode(action game.Action) (s string)
+	Decode(s string) (action game.Action, err error)
+}
+
+// StringCodec is a codec of actions which are strings.
+type StringCodec struct{}
+
+// Encode returns action as string.
+func (StringCodec) Encode(action game.Action) (s string) {
+	return fmt.Sprint(action)
+}
+
+// Decode returns s as action.
+func (StringCodec) Decode(s string) (action game.Action, err error) {
+	return s, err
+}
+
+// StateCodec is a Codec which also encodes states of a game, so the log can have the start state.
+type StateCodec interface {
+	Codec
+	EncodeState(state game.State) (s string, err error)
+	DecodeState(s string) (state game.State, err error)
+}
+
+// Recorder is a deck which records operations to log.
+// Codec encodes actions of events for Listen, and StringCodec is used if it is nil.
+// If Codec is a StateCodec, Listen records the start state of the game at the first event.
+type Recorder struct {
+	Codec Codec
+	deck  gocard.Deck
+	rng   *rand.Rand
+	log   Log
+}
+
+// NewRecorder returns recorder of deck with seed of random source.
+func NewRecorder(deck gocard.Deck, seed int64) (recorder *Recorder) {
+	return &Recorder{
+		deck: append(gocard.Deck{}, deck...),
+		rng:  rand.New(rand.NewSource(seed)),
+		log:  Log{Seed: seed, Deck: append(gocard.Deck{}, deck...)},
+	}
+}
+
+// Deck returns copy of current deck.
+func (recorder *Recorder) Deck() (deck gocard.Deck) {
+	return append(gocard.Deck{}, recorder.deck...)
+}
+
+// Log returns copy of recorded log.
+func (recorder *Recorder) Log() (log Log) {
+	log = recorder.log
+	log.Deck = append(gocard.Deck{}, log.Deck...)
+	log.Entries = append([]Entry{}, log.Entries...)
+	return log
+}
+
+// Shuffle shuffles the deck and records it.
+func (recorder *Recorder) Shuffle() {
+	recorder.deck.ShuffleWith(recorder.rng)
+	recorder.log.Entries = append(recorder.log.Entries, Entry{Op: SHUFFLE})
+}
+
+// Draw draws card from the top of the deck and records it, and returns error of empty deck.
+func (recorder *Recorder) Draw() (card gocard.Card, err error) {
+	if card, err = recorder.deck.Draw(); err != nil {
+		return card, err
+	}
+	recorder.log.Entries = append(recorder.log.Entries, Entry{Op: DRAW, Card: &card})
+	return card, err
+}
+
+// PutTop puts a card on the top of the deck and records it.
+func (recorder *Recorder) PutTop(card gocard.Card) {
+	recorder.deck.PutTop(card)
+	recorder.log.Entries = append(recorder.log.Entries, Entry{Op: PUTTOP, Card: &card})
+}
+
+// PutBottom puts a card on the bottom of the deck and records it.
+func (recorder *Recorder) PutBottom(card gocard.Card) {
+	recorder.deck.PutBottom(card)
+	recorder.log.Entries = append(recorder.log.Entries, Entry{Op: PUTBOTTOM, Card: &card})
+}
+
+// Action records action of player of seat.
+func (recorder *Recorder) Action(seat int, action string) {
+	recorder.log.Entries = append(recorder.log.Entries, Entry{Op: ACTION, Seat: seat, Action: action})
+}
+
+// Start records state as the start state of the game encoded by codec, and returns error of codec.
+func (recorder *Recorder) Start(state game.State, codec StateCodec) (err error) {
+	start, err := codec.EncodeState(state)
+	if err != nil {
+		return err
+	}
+	recorder.log.Start = start
+	return err
+}
+
+// Listen records action of event encoded by Codec. It can be subscribed to game.Game.
+// The start state is recorded at the first event if Codec is a StateCodec and it is not recorded yet.
+func (recorder *Recorder) Listen(event game.Event) {
+	codec := recorder.Codec
+	if codec == nil {
+		codec = StringCodec{}
+	}
+	if stateCodec, ok := codec.(StateCodec); ok && recorder.log.Start == "" && event.Step == 1 {
+		// Replaying the log reports that the start state is not recorded if encoding fails.
+		recorder.Start(event.Before, stateCodec)
+	}
+	recorder.Action(event.Seat, codec.Encode(event.Action))
+}
@@ -0,0 +1,61 @@
+package replay
+
+import (
+	"bytes"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupRecorder() (recorder *Recorder) {
+	recorder = NewRecorder(gocard.NewDeck(), 7)
+	recorder.Shuffle()
+	card, _ := recorder.Draw()
+	recorder.Action(0, "hit")
+	recorder.PutBottom(card)
+	recorder.Draw()
+	recorder.PutTop(gocard.Card{Rank: gocard.ACE, Suit: gocard.SPADES})
+	recorder.Shuffle()
+	return recorder
+}
+
+// #################################
+// Test WriteLog(), ReadLog()
+// #################################
+
+func TestWriteReadLog(t *testing.T) {
+	log := setupRecorder().Log()
+	buf := &bytes.Buffer{}
+	if err := WriteLog(buf, log); err != nil {
+		t.Fatalf("Couldn't write log\nError: %v", err)
+	}
+
+	read, err := ReadLog(buf)
+	if err != nil {
+		t.Fatalf("Couldn't read log\nError: %v", err)
+	}
+	if read.Seed != log.Seed || len(read.Deck) != 52 || len(read.Entries) != len(log.Entries) {
+		expected := log
+		actual := read
+		msg := "Read log is not same as written log"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	for i := range log.Entries {
+		if read.Entries[i].String() != log.Entries[i].String() {
+			expected := log.Entries[i]
+			actual := read.Entries[i]
+			msg := "Read entry is not same as written entry"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func TestReadLogInvalid(t *testing.T) {
+	if _, err := ReadLog(bytes.NewBufferString("{")); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as reading invalid log"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,161 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	gocard "github.com/x-color/gocard"
+	"github.com/x-color/gocard/game"
+)
+
+// Replayer replays log step by step. Step is number of replayed entries.
+// If Rules is not nil, actions are decoded by Codec and applied to State.
+type Replayer struct {
+	Log   Log
+	Deck  gocard.Deck
+	Step  int
+	Rules game.Rules
+	Codec Codec
+	State game.State
+	start game.State
+	rng   *rand.Rand
+}
+
+// NewReplayer returns replayer of the deck at the start of log. Actions are not applied.
+func NewReplayer(log Log) (replayer *Replayer) {
+	replayer = &Replayer{Log: log}
+	replayer.reset()
+	return replayer
+}
+
+// NewGameReplayer returns replayer of the deck and the game at the start of log.
+// The start state is decoded from log by codec, and actions are decoded by codec and applied with rules.
+// It returns error if log has no start state or it couldn't be decoded.
+func NewGameReplayer(log Log, rules game.Rules, codec StateCodec) (replayer *Replayer, err error) {
+	if log.Start == "" {
+		err = errors.New("couldn't replay game, start state is not recorded")
+		return replayer, err
+	}
+	start, err := codec.DecodeState(log.Start)
+	if err != nil {
+		return replayer, err
+	}
+	replayer = &Replayer{Log: log, Rules: rules, Codec: codec, start: start}
+	replayer.reset()
+	return replayer, err
+}
+
+// reset moves replayer to the start of log.
+func (replayer *Replayer) reset() {
+	replayer.Deck = append(gocard.Deck{}, replayer.Log.Deck...)
+	replayer.Step = 0
+	replayer.State = replayer.start
+	replayer.rng = rand.New(rand.NewSource(replayer.Log.Seed))
+}
+
+// apply decodes action of entry and applies it to the state.
+// It returns error if the action is not legal for the player to act.
+func (replayer *Replayer) apply(entry Entry) (err error) {
+	action, err := replayer.Codec.Decode(entry.Action)
+	if err != nil {
+		return fmt.Errorf("couldn't replay step %d, %v", replayer.Step+1, err)
+	}
+	if replayer.State.Done() || replayer.State.Turn() != entry.Seat {
+		return fmt.Errorf("couldn't replay step %d, it is not turn of seat %d", replayer.Step+1, entry.Seat)
+	}
+	legal := false
+	for _, a := range replayer.Rules.Legal(replayer.State, entry.Seat) {
+		legal = legal || a == action
+	}
+	if !legal {
+		return fmt.Errorf("couldn't replay step %d, %v is illegal for seat %d", replayer.Step+1, action, entry.Seat)
+	}
+	next, err := replayer.Rules.Apply(replayer.State, entry.Seat, action)
+	if err != nil {
+		return err
+	}
+	replayer.State = next
+	return err
+}
+
+// Done returns whether all entries are replayed.
+func (replayer *Replayer) Done() (done bool) {
+	return replayer.Step >= len(replayer.Log.Entries)
+}
+
+// Next replays the next entry and returns it.
+// It returns error if the log ends, the drawn card is different from the recorded card
+// or the action couldn't be applied.
+func (replayer *Replayer) Next() (entry Entry, err error) {
+	if replayer.Done() {
+		err = errors.New("couldn't replay, log ends")
+		return entry, err
+	}
+	entry = replayer.Log.Entries[replayer.Step]
+	if (entry.Op == DRAW || entry.Op == PUTTOP || entry.Op == PUTBOTTOM) && entry.Card == nil {
+		err = fmt.Errorf("couldn't replay step %d, card is not recorded", replayer.Step+1)
+		return entry, err
+	}
+	switch entry.Op {
+	case SHUFFLE:
+		replayer.Deck.ShuffleWith(replayer.rng)
+	case DRAW:
+		card, err := replayer.Deck.Draw()
+		if err != nil {
+			return entry, err
+		}
+		if card != *entry.Card {
+			err = fmt.Errorf("couldn't replay step %d, drawn card is %s but recorded card is %s", replayer.Step+1, card, entry.Card)
+			return entry, err
+		}
+	case PUTTOP:
+		replayer.Deck.PutTop(*entry.Card)
+	case PUTBOTTOM:
+		replayer.Deck.PutBottom(*entry.Card)
+	case ACTION:
+		if replayer.Rules == nil {
+			break
+		}
+		if err = replayer.apply(entry); err != nil {
+			return entry, err
+		}
+	default:
+		err = fmt.Errorf("couldn't replay step %d, operation %q is unknown", replayer.Step+1, entry.Op)
+		return entry, err
+	}
+	replayer.Step++
+	return entry, err
+}
+
+// Seek replays log from the start to step, and returns error if log couldn't be replayed.
+func (replayer *Replayer) Seek(step int) (err error) {
+	if step < 0 || step > len(replayer.Log.Entries) {
+		return fmt.Errorf("couldn't seek, step %d is out of log", step)
+	}
+	replayer.reset()
+	for replayer.Step < step {
+		if _, err = replayer.Next(); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// Replay returns the deck after step entries of log, and returns error if log couldn't be replayed.
+func Replay(log Log, step int) (deck gocard.Deck, err error) {
+	replayer := NewReplayer(log)
+	err = replayer.Seek(step)
+	return replayer.Deck, err
+}
+
+// ReplayGame returns state of the game after step entries of log, and returns error if log couldn't be replayed.
+// The start state and actions are decoded from log by codec, and actions are applied with rules.
+func ReplayGame(log Log, rules game.Rules, codec StateCodec, step int) (state game.State, err error) {
+	replayer, err := NewGameReplayer(log, rules, codec)
+	if err != nil {
+		return state, err
+	}
+	err = replayer.Seek(step)
+	return replayer.State, err
+}
@@ -0,0 +1,187 @@
+package replay
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+	"github.com/x-color/gocard/game"
+)
+
+// #################################
+// Test Replay()
+// #################################
+
+func TestReplay(t *testing.T) {
+	recorder := setupRecorder()
+	log := recorder.Log()
+
+	deck, err := Replay(log, len(log.Entries))
+	if err != nil {
+		t.Fatalf("Couldn't replay log\nError: %v", err)
+	}
+	for i, card := range recorder.Deck() {
+		if deck[i] != card {
+			expected := card
+			actual := deck[i]
+			msg := "Replayed deck is not same as recorded deck"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func TestReplayTampered(t *testing.T) {
+	log := setupRecorder().Log()
+	log.Entries[1].Card = &gocard.Card{Rank: gocard.KING, Suit: gocard.HEARTS}
+	log.Entries[4].Card = &gocard.Card{Rank: gocard.KING, Suit: gocard.HEARTS}
+
+	if _, err := Replay(log, len(log.Entries)); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as replaying tampered log"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Replayer.Seek()
+// #################################
+
+func TestSeek(t *testing.T) {
+	log := setupRecorder().Log()
+	replayer := NewReplayer(log)
+	replayer.Seek(len(log.Entries))
+
+	if err := replayer.Seek(2); err != nil || replayer.Step != 2 || len(replayer.Deck) != 51 || replayer.Deck[50] == *log.Entries[1].Card {
+		expected := 51
+		actual := len(replayer.Deck)
+		msg := "Expected replayer goes back to the step, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if entry, err := replayer.Next(); err != nil || entry.Op != ACTION || entry.Action != "hit" {
+		expected := "action seat 0 hit"
+		actual := entry
+		msg := "Next entry is not expected entry"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := replayer.Seek(100); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as seeking out of log"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// Setup for test
+// countRules is rules of a game which players add 1 or 2 in turn until total reaches 10.
+type countRules struct{}
+
+type countState struct {
+	turn  int
+	total int
+}
+
+func (s countState) Turn() (seat int) {
+	return s.turn
+}
+
+func (s countState) Done() (done bool) {
+	return s.total >= 10
+}
+
+// Start starts from random total, so replay must not assume the start state.
+func (countRules) Start(players int) (state game.State, err error) {
+	return countState{turn: rand.Intn(2), total: rand.Intn(5)}, err
+}
+
+func (countRules) Legal(state game.State, seat int) (actions []game.Action) {
+	return []game.Action{"1", "2"}
+}
+
+func (countRules) Apply(state game.State, seat int, action game.Action) (next game.State, err error) {
+	s := state.(countState)
+	n, err := strconv.Atoi(action.(string))
+	return countState{turn: 1 - s.turn, total: s.total + n}, err
+}
+
+func (countRules) Score(state game.State) (scores []int) {
+	return []int{state.(countState).turn, 1 - state.(countState).turn}
+}
+
+// countCodec is a codec of actions and states of countRules.
+type countCodec struct {
+	StringCodec
+}
+
+func (countCodec) EncodeState(state game.State) (s string, err error) {
+	c := state.(countState)
+	return fmt.Sprintf("%d %d", c.turn, c.total), err
+}
+
+func (countCodec) DecodeState(s string) (state game.State, err error) {
+	c := countState{}
+	_, err = fmt.Sscanf(s, "%d %d", &c.turn, &c.total)
+	return c, err
+}
+
+// #################################
+// Test ReplayGame()
+// #################################
+
+func TestReplayGame(t *testing.T) {
+	player := game.PlayerFunc(func(state game.State, seat int, legal []game.Action) (game.Action, error) {
+		return legal[state.(countState).total%2], nil
+	})
+	g, _ := game.NewGame(countRules{}, []game.Player{player, player})
+	recorder := NewRecorder(gocard.NewDeck(), 1)
+	recorder.Codec = countCodec{}
+	g.Subscribe(recorder.Listen)
+	g.Run()
+
+	// Replay from JSON only.
+	buf := &bytes.Buffer{}
+	WriteLog(buf, recorder.Log())
+	log, err := ReadLog(buf)
+	if err != nil {
+		t.Fatalf("Couldn't read log\nError: %v", err)
+	}
+	for step := 0; step <= len(log.Entries); step++ {
+		state, err := ReplayGame(log, countRules{}, countCodec{}, step)
+		snapshot, _ := g.Snapshot(step)
+		if err != nil || state != snapshot {
+			expected := snapshot
+			actual := state
+			msg := "Replayed state is not same as recorded state"
+			t.Fatalf("%s (step %d)\nExpected: %v\nActual  : %v (%v)", msg, step, expected, actual, err)
+		}
+	}
+}
+
+func TestReplayGameWithoutStart(t *testing.T) {
+	recorder := NewRecorder(gocard.NewDeck(), 1)
+	recorder.Action(0, "1")
+
+	if _, err := ReplayGame(recorder.Log(), countRules{}, countCodec{}, 1); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as replaying game without start state"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestReplayGameIllegalAction(t *testing.T) {
+	recorder := NewRecorder(gocard.NewDeck(), 1)
+	recorder.Start(countState{}, countCodec{})
+	recorder.Action(0, "1")
+	recorder.Action(0, "2")
+
+	if _, err := ReplayGame(recorder.Log(), countRules{}, countCodec{}, 2); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as replaying action out of turn"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,112 @@
+package card
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// Decker is method set of decks. Deck and RingDeck implement it.
+type Decker interface {
+	Shuffle()
+	ShuffleWith(r *rand.Rand)
+	Draw() (card Card, err error)
+	PutTop(card Card)
+	PutBottom(card Card)
+}
+
+// RingDeck is a deck backed by ring buffer.
+// Draw, PutTop and PutBottom take constant time and allocate nothing unless the buffer grows.
+type RingDeck struct {
+	cards []Card
+	head  int
+	size  int
+}
+
+// NewRingDeck returns ring deck of copy of deck. The first card of deck is the top.
+func NewRingDeck(deck Deck) (ring *RingDeck) {
+	size := len(deck)
+	if size < 1 {
+		size = 1
+	}
+	ring = &RingDeck{cards: make([]Card, size)}
+	ring.size = copy(ring.cards, deck)
+	return ring
+}
+
+// Len returns number of cards in the deck.
+func (ring *RingDeck) Len() (length int) {
+	return ring.size
+}
+
+// Deck returns copy of cards in the deck from the top.
+func (ring *RingDeck) Deck() (deck Deck) {
+	deck = make(Deck, ring.size)
+	for i := range deck {
+		deck[i] = ring.at(i)
+	}
+	return deck
+}
+
+// at returns i-th card from the top.
+func (ring *RingDeck) at(i int) (card Card) {
+	return ring.cards[(ring.head+i)%len(ring.cards)]
+}
+
+// grow doubles the buffer if it is full.
+func (ring *RingDeck) grow() {
+	if ring.size < len(ring.cards) {
+		return
+	}
+	cards := make([]Card, 2*len(ring.cards))
+	for i := 0; i < ring.size; i++ {
+		cards[i] = ring.at(i)
+	}
+	ring.cards, ring.head = cards, 0
+}
+
+// Shuffle shuffles the deck.
+func (ring *RingDeck) Shuffle() {
+	ring.shuffle(rand.Intn)
+}
+
+// ShuffleWith shuffles the deck with random source r. Same source shuffles the deck in same order.
+func (ring *RingDeck) ShuffleWith(r *rand.Rand) {
+	ring.shuffle(r.Intn)
+}
+
+// shuffle shuffles the deck in same way as Deck.Shuffle with intn.
+func (ring *RingDeck) shuffle(intn func(n int) int) {
+	n := len(ring.cards)
+	for i := ring.size; i > 0; i-- {
+		randIndex := intn(i)
+		a, b := (ring.head+i-1)%n, (ring.head+randIndex)%n
+		ring.cards[a], ring.cards[b] = ring.cards[b], ring.cards[a]
+	}
+}
+
+// Draw draws card from the top of the deck and returns drawn card, error of empty deck.
+func (ring *RingDeck) Draw() (card Card, err error) {
+	if ring.size == 0 {
+		err = errors.New("couldn't draw, deck is empty")
+		return card, err
+	}
+	card = ring.cards[ring.head]
+	ring.head = (ring.head + 1) % len(ring.cards)
+	ring.size--
+	return card, err
+}
+
+// PutTop puts a card on the top of the deck.
+func (ring *RingDeck) PutTop(card Card) {
+	ring.grow()
+	ring.head = (ring.head - 1 + len(ring.cards)) % len(ring.cards)
+	ring.cards[ring.head] = card
+	ring.size++
+}
+
+// PutBottom puts a card on the bottom of the deck.
+func (ring *RingDeck) PutBottom(card Card) {
+	ring.grow()
+	ring.cards[(ring.head+ring.size)%len(ring.cards)] = card
+	ring.size++
+}
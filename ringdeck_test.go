@@ -0,0 +1,119 @@
+package card
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// #################################
+// Test Decker
+// #################################
+
+func TestDecker(t *testing.T) {
+	for _, deck := range []Decker{&Deck{}, NewRingDeck(Deck{})} {
+		deck.PutTop(Card{Rank: TWO, Suit: SPADES})
+		deck.PutTop(Card{Rank: ACE, Suit: SPADES})
+		deck.PutBottom(Card{Rank: THREE, Suit: SPADES})
+
+		for _, rank := range []Rank{ACE, TWO, THREE} {
+			if card, err := deck.Draw(); err != nil || card.Rank != rank {
+				expected := Card{Rank: rank, Suit: SPADES}
+				actual := card
+				msg := "Card drawn is not expected card"
+				t.Fatalf("%s (%T)\nExpected: %v\nActual  : %v (%v)", msg, deck, expected, actual, err)
+			}
+		}
+		if _, err := deck.Draw(); err == nil {
+			expected := "error"
+			actual := err
+			msg := "Couldn't catch error as drawing from empty deck"
+			t.Fatalf("%s (%T)\nExpected: %v\nActual  : %v", msg, deck, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test RingDeck.PutTop(), RingDeck.PutBottom()
+// #################################
+
+func TestRingDeckWrapAround(t *testing.T) {
+	ring := NewRingDeck(NewDeck())
+	for i := 0; i < 100; i++ {
+		card, _ := ring.Draw()
+		ring.PutBottom(card)
+	}
+	for i := 0; i < 10; i++ {
+		ring.PutTop(Card{Rank: ACE, Suit: HEARTS})
+	}
+
+	deck := ring.Deck()
+	if ring.Len() != 62 || len(deck) != 62 {
+		expected := 62
+		actual := ring.Len()
+		msg := "Number of cards is not expected number"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	// 100 cards moved from the top to the bottom, so the 49th card of new deck is the top.
+	if expected := NewDeck()[100%52]; deck[10] != expected {
+		actual := deck[10]
+		msg := "Card under put cards is not expected card"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test RingDeck.ShuffleWith()
+// #################################
+
+func TestRingDeckShuffleWith(t *testing.T) {
+	deck := NewDeck()
+	deck.ShuffleWith(rand.New(rand.NewSource(1)))
+	ring := NewRingDeck(NewDeck())
+	ring.ShuffleWith(rand.New(rand.NewSource(1)))
+
+	actual := ring.Deck()
+	for i := range deck {
+		if deck[i] != actual[i] {
+			expected := deck
+			msg := "Expected RingDeck is shuffled in same order as Deck with same source, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test allocations of RingDeck
+// #################################
+
+func TestRingDeckNoAllocation(t *testing.T) {
+	ring := NewRingDeck(NewDeck())
+	allocs := testing.AllocsPerRun(1000, func() {
+		card, _ := ring.Draw()
+		ring.PutTop(card)
+		card, _ = ring.Draw()
+		ring.PutBottom(card)
+	})
+
+	if allocs != 0 {
+		expected := 0.0
+		actual := allocs
+		msg := "Expected Draw, PutTop and PutBottom allocate nothing in steady state, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func BenchmarkDeckPutTop(b *testing.B) {
+	deck := NewDeck()
+	for i := 0; i < b.N; i++ {
+		card, _ := deck.Draw()
+		deck.PutTop(card)
+	}
+}
+
+func BenchmarkRingDeckPutTop(b *testing.B) {
+	ring := NewRingDeck(NewDeck())
+	for i := 0; i < b.N; i++ {
+		card, _ := ring.Draw()
+		ring.PutTop(card)
+	}
+}
@@ -0,0 +1,86 @@
+package rummy
+
+import (
+	"fmt"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// These constant values are rules of knocking in Gin Rummy.
+const (
+	KnockLimit    = 10
+	GinBonus      = 25
+	UndercutBonus = 25
+)
+
+// Result is result of a hand of Gin Rummy.
+// Winner is 0 if the knocker wins, 1 if the defender wins, and Points is points won by the winner.
+type Result struct {
+	Winner   int
+	Points   int
+	Gin      bool
+	Undercut bool
+	// Melds and Deadwood are melds and deadwood of the knocker and the defender.
+	Melds    [2][]Meld
+	Deadwood [2]gocard.Cards
+}
+
+// LayOff lays off cards onto melds, and returns extended melds and cards which couldn't be laid off.
+func (rules Rules) LayOff(melds []Meld, cards gocard.Cards) (extended []Meld, remaining gocard.Cards) {
+	extended = make([]Meld, len(melds))
+	copy(extended, melds)
+	remaining = append(gocard.Cards{}, cards...)
+	for laid := true; laid; {
+		laid = false
+		for i := 0; i < len(remaining); i++ {
+			for j, meld := range extended {
+				m, err := rules.NewMeld(append(append(gocard.Cards{}, meld.Cards...), remaining[i]))
+				if err != nil || m.Type != meld.Type {
+					continue
+				}
+				extended[j] = m
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				i--
+				laid = true
+				break
+			}
+		}
+	}
+	return extended, remaining
+}
+
+// Knock scores a hand of Gin Rummy knocked by knocker, and returns error if knocker couldn't knock.
+// If the knocker has no deadwood (gin), the knocker scores deadwood of the defender and GinBonus.
+// Otherwise the defender lays off deadwood onto melds of the knocker, and if deadwood of the defender
+// is not more than deadwood of the knocker (undercut), the defender scores the difference and UndercutBonus.
+func (rules Rules) Knock(knocker gocard.Cards, defender gocard.Cards) (result Result, err error) {
+	var knockerDeadwood, defenderDeadwood int
+	result.Melds[0], result.Deadwood[0], err = rules.Arrange(knocker)
+	if err != nil {
+		return result, err
+	}
+	if knockerDeadwood = rules.Deadwood(result.Deadwood[0]); knockerDeadwood > KnockLimit {
+		err = fmt.Errorf("couldn't knock, deadwood %d is more than %d", knockerDeadwood, KnockLimit)
+		return result, err
+	}
+	result.Melds[1], result.Deadwood[1], err = rules.Arrange(defender)
+	if err != nil {
+		return result, err
+	}
+	if knockerDeadwood == 0 {
+		result.Gin = true
+		result.Points = rules.Deadwood(result.Deadwood[1]) + GinBonus
+		return result, err
+	}
+
+	result.Melds[0], result.Deadwood[1] = rules.LayOff(result.Melds[0], result.Deadwood[1])
+	defenderDeadwood = rules.Deadwood(result.Deadwood[1])
+	if defenderDeadwood <= knockerDeadwood {
+		result.Winner = 1
+		result.Undercut = true
+		result.Points = knockerDeadwood - defenderDeadwood + UndercutBonus
+		return result, err
+	}
+	result.Points = defenderDeadwood - knockerDeadwood
+	return result, err
+}
@@ -0,0 +1,98 @@
+package rummy
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupMelded() (hand gocard.Cards) {
+	return gocard.Cards{
+		setupCard(gocard.ACE, gocard.SPADES),
+		setupCard(gocard.TWO, gocard.SPADES),
+		setupCard(gocard.THREE, gocard.SPADES),
+		setupCard(gocard.NINE, gocard.HEARTS),
+		setupCard(gocard.NINE, gocard.DIAMONDS),
+		setupCard(gocard.NINE, gocard.CLUBS),
+		setupCard(gocard.FOUR, gocard.DIAMONDS),
+		setupCard(gocard.FIVE, gocard.DIAMONDS),
+		setupCard(gocard.SIX, gocard.DIAMONDS),
+		setupCard(gocard.SEVEN, gocard.DIAMONDS),
+	}
+}
+
+// #################################
+// Test Rules.LayOff()
+// #################################
+
+func TestLayOff(t *testing.T) {
+	rules := Rules{}
+	melds, _, _ := rules.Arrange(setupMelded())
+	cards := gocard.Cards{
+		setupCard(gocard.EIGHT, gocard.DIAMONDS),
+		setupCard(gocard.NINE, gocard.DIAMONDS),
+		setupCard(gocard.NINE, gocard.SPADES),
+		setupCard(gocard.KING, gocard.CLUBS),
+	}
+
+	_, remaining := rules.LayOff(melds, cards)
+	if len(remaining) != 1 || remaining[0] != setupCard(gocard.KING, gocard.CLUBS) {
+		expected := gocard.Cards{setupCard(gocard.KING, gocard.CLUBS)}
+		actual := remaining
+		msg := "Expected cards are laid off onto run and set, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Rules.Knock()
+// #################################
+
+func TestKnockGin(t *testing.T) {
+	rules := Rules{}
+	defender := gocard.Cards{
+		setupCard(gocard.KING, gocard.HEARTS),
+		setupCard(gocard.KING, gocard.SPADES),
+		setupCard(gocard.TWO, gocard.CLUBS),
+	}
+
+	result, err := rules.Knock(setupMelded(), defender)
+	if err != nil || !result.Gin || result.Winner != 0 || result.Points != 22+GinBonus {
+		expected := 22 + GinBonus
+		actual := result
+		msg := "Expected knocker gets deadwood of defender and gin bonus, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %+v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestKnockUndercut(t *testing.T) {
+	rules := Rules{}
+	knocker := append(setupMelded()[:9], setupCard(gocard.EIGHT, gocard.HEARTS))
+	defender := gocard.Cards{
+		setupCard(gocard.SEVEN, gocard.DIAMONDS),
+		setupCard(gocard.TWO, gocard.HEARTS),
+		setupCard(gocard.THREE, gocard.HEARTS),
+	}
+
+	result, err := rules.Knock(knocker, defender)
+	if err != nil || !result.Undercut || result.Winner != 1 || result.Points != 3+UndercutBonus {
+		expected := 3 + UndercutBonus
+		actual := result
+		msg := "Expected defender undercuts knocker after laying off, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %+v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestKnockTooMuchDeadwood(t *testing.T) {
+	rules := Rules{}
+	knocker := append(setupMelded()[:9], setupCard(gocard.KING, gocard.HEARTS))
+	knocker[0] = setupCard(gocard.TWO, gocard.HEARTS)
+
+	if _, err := rules.Knock(knocker, gocard.Cards{}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as knocking with deadwood more than 10"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
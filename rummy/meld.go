@@ -0,0 +1,292 @@
+/*
+Package rummy implements melds and deadwood scoring of Rummy and Gin Rummy.
+
+A meld is a set (3 or 4 cards of same rank) or a run (3 or more cards of same suit in sequence).
+Wild cards (e.g. Joker) can substitute any card in melds.
+*/
+package rummy
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Joker is a joker card. It is a zero Card because gocard has no joker.
+var Joker = gocard.Card{}
+
+// Rules is rules of melds and deadwood.
+// If AceHigh is true, Ace can be used after King in runs. (e.g. Queen, King, Ace)
+// Wild returns whether card is wild card, only Joker is wild if it is nil.
+// WildValue is deadwood value of wild card.
+type Rules struct {
+	AceHigh   bool
+	Wild      func(card gocard.Card) bool
+	WildValue int
+}
+
+// MeldType is type of meld. (SET, RUN)
+type MeldType int
+
+// These constant values are types of meld.
+const (
+	SET MeldType = iota + 1
+	RUN
+)
+
+// String returns string of type of meld. (e.g. Set)
+func (t MeldType) String() (msg string) {
+	switch t {
+	case SET:
+		return "Set"
+	case RUN:
+		return "Run"
+	default:
+		return "Unknown"
+	}
+}
+
+// Meld is a meld of cards.
+type Meld struct {
+	Type  MeldType
+	Cards gocard.Cards
+}
+
+// String returns string of meld. (e.g. Run [Two of Spades Three of Spades Four of Spades])
+func (meld Meld) String() (msg string) {
+	return fmt.Sprintf("%s %v", meld.Type, meld.Cards)
+}
+
+// IsWild returns whether card is wild card.
+func (rules Rules) IsWild(card gocard.Card) (wild bool) {
+	if rules.Wild == nil {
+		return card == Joker
+	}
+	return rules.Wild(card)
+}
+
+// Value returns deadwood value of card. (Ace: 1, Two ~ Ten: 2 ~ 10, Jack ~ King: 10)
+func (rules Rules) Value(card gocard.Card) (value int) {
+	switch {
+	case rules.IsWild(card):
+		return rules.WildValue
+	case card.Rank >= gocard.TEN:
+		return 10
+	default:
+		return int(card.Rank)
+	}
+}
+
+// Deadwood returns total deadwood value of cards.
+func (rules Rules) Deadwood(cards gocard.Cards) (value int) {
+	for _, card := range cards {
+		value += rules.Value(card)
+	}
+	return value
+}
+
+// NewMeld returns meld of cards, and returns error if cards are neither a set nor a run.
+func (rules Rules) NewMeld(cards gocard.Cards) (meld Meld, err error) {
+	cards = append(gocard.Cards{}, cards...)
+	if rules.isSet(cards) {
+		return Meld{Type: SET, Cards: cards}, err
+	}
+	if rules.isRun(cards) {
+		return Meld{Type: RUN, Cards: rules.sortRun(cards)}, err
+	}
+	err = fmt.Errorf("couldn't meld %v, it is neither a set nor a run", cards)
+	return meld, err
+}
+
+// naturals returns cards in cards which are not wild, and number of wild cards.
+func (rules Rules) naturals(cards gocard.Cards) (naturals gocard.Cards, wilds int) {
+	for _, card := range cards {
+		if rules.IsWild(card) {
+			wilds++
+		} else {
+			naturals = append(naturals, card)
+		}
+	}
+	return naturals, wilds
+}
+
+// isSet returns whether cards are 3 or 4 cards of same rank and different suits.
+func (rules Rules) isSet(cards gocard.Cards) (ok bool) {
+	if len(cards) < 3 || len(cards) > 4 {
+		return false
+	}
+	naturals, _ := rules.naturals(cards)
+	if len(naturals) == 0 {
+		return false
+	}
+	seen := map[gocard.Suit]bool{}
+	for _, card := range naturals {
+		if card.Rank != naturals[0].Rank || seen[card.Suit] {
+			return false
+		}
+		seen[card.Suit] = true
+	}
+	return true
+}
+
+// isRun returns whether cards are 3 or more cards of same suit in sequence.
+func (rules Rules) isRun(cards gocard.Cards) (ok bool) {
+	_, ok = rules.runStart(cards)
+	return ok
+}
+
+// runStart returns the lowest rank number of run of cards. (Ace is 1 or 14)
+func (rules Rules) runStart(cards gocard.Cards) (start int, ok bool) {
+	if len(cards) < 3 || len(cards) > 13 {
+		return start, false
+	}
+	naturals, wilds := rules.naturals(cards)
+	if len(naturals) == 0 {
+		return start, false
+	}
+	for _, aceHigh := range []bool{false, true} {
+		if aceHigh && !rules.AceHigh {
+			continue
+		}
+		numbers := map[int]bool{}
+		low, high := 15, 0
+		for _, card := range naturals {
+			n := int(card.Rank)
+			if aceHigh && card.Rank == gocard.ACE {
+				n = 14
+			}
+			if card.Suit != naturals[0].Suit || numbers[n] {
+				return start, false
+			}
+			numbers[n] = true
+			if n < low {
+				low = n
+			}
+			if n > high {
+				high = n
+			}
+		}
+		if gaps := high - low + 1 - len(naturals); gaps > wilds {
+			continue
+		}
+		// Remaining wild cards extend the run downward as far as possible, then upward.
+		start = low - (len(cards) - (high - low + 1))
+		top := 13
+		if aceHigh {
+			top = 14
+		}
+		if start < 1 {
+			start = 1
+		}
+		if aceHigh && start < 2 {
+			start = 2
+		}
+		if start+len(cards)-1 <= top {
+			return start, true
+		}
+	}
+	return start, false
+}
+
+// sortRun returns cards of run in order of sequence. Wild cards are placed at positions they substitute.
+func (rules Rules) sortRun(cards gocard.Cards) (sorted gocard.Cards) {
+	start, _ := rules.runStart(cards)
+	naturals, _ := rules.naturals(cards)
+	var wilds gocard.Cards
+	for _, card := range cards {
+		if rules.IsWild(card) {
+			wilds = append(wilds, card)
+		}
+	}
+	for n := start; n < start+len(cards); n++ {
+		found := false
+		for _, card := range naturals {
+			if int(card.Rank) == n || (n == 14 && card.Rank == gocard.ACE) {
+				sorted = append(sorted, card)
+				found = true
+			}
+		}
+		if !found {
+			sorted = append(sorted, wilds[0])
+			wilds = wilds[1:]
+		}
+	}
+	return sorted
+}
+
+// Arrange returns melds and deadwood of hand which minimize deadwood value.
+// It returns error if hand has more than 16 cards.
+func (rules Rules) Arrange(hand gocard.Cards) (melds []Meld, deadwood gocard.Cards, err error) {
+	n := len(hand)
+	if n > 16 {
+		err = errors.New("couldn't arrange, hand has more than 16 cards")
+		return melds, deadwood, err
+	}
+
+	// candidates[i] is masks of melds which lowest card is i-th card.
+	candidates := make([][]uint32, n)
+	for mask := uint32(1); mask < 1<<uint(n); mask++ {
+		cards := subset(hand, mask)
+		if len(cards) < 3 || !(rules.isSet(cards) || rules.isRun(cards)) {
+			continue
+		}
+		lowest := 0
+		for mask&(1<<uint(lowest)) == 0 {
+			lowest++
+		}
+		candidates[lowest] = append(candidates[lowest], mask)
+	}
+
+	// best[mask] is the minimum deadwood of cards in mask, and choice[mask] is the meld used for it.
+	best := map[uint32]int{0: 0}
+	choice := map[uint32]uint32{}
+	var solve func(mask uint32) int
+	solve = func(mask uint32) int {
+		if v, ok := best[mask]; ok {
+			return v
+		}
+		lowest := 0
+		for mask&(1<<uint(lowest)) == 0 {
+			lowest++
+		}
+		bit := uint32(1) << uint(lowest)
+		v := rules.Value(hand[lowest]) + solve(mask&^bit)
+		choice[mask] = bit
+		for _, meld := range candidates[lowest] {
+			if meld&mask == meld {
+				if w := solve(mask &^ meld); w < v {
+					v = w
+					choice[mask] = meld
+				}
+			}
+		}
+		best[mask] = v
+		return v
+	}
+	mask := uint32(1)<<uint(n) - 1
+	solve(mask)
+	for mask != 0 {
+		used := choice[mask]
+		if cards := subset(hand, used); len(cards) == 1 {
+			deadwood = append(deadwood, cards...)
+		} else {
+			meld, _ := rules.NewMeld(cards)
+			melds = append(melds, meld)
+		}
+		mask &^= used
+	}
+	sort.Sort(gocard.ByRank{Cards: deadwood})
+	return melds, deadwood, err
+}
+
+// subset returns cards in mask.
+func subset(cards gocard.Cards, mask uint32) (sub gocard.Cards) {
+	for i, card := range cards {
+		if mask&(1<<uint(i)) != 0 {
+			sub = append(sub, card)
+		}
+	}
+	return sub
+}
@@ -0,0 +1,124 @@
+package rummy
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupCard(rank gocard.Rank, suit gocard.Suit) (card gocard.Card) {
+	return gocard.Card{Rank: rank, Suit: suit}
+}
+
+// #################################
+// Test Rules.NewMeld()
+// #################################
+
+func TestNewMeldSet(t *testing.T) {
+	rules := Rules{}
+	cards := gocard.Cards{
+		setupCard(gocard.SEVEN, gocard.SPADES),
+		setupCard(gocard.SEVEN, gocard.HEARTS),
+		setupCard(gocard.SEVEN, gocard.CLUBS),
+	}
+
+	if meld, err := rules.NewMeld(cards); err != nil || meld.Type != SET {
+		expected := SET
+		actual := meld.Type
+		msg := "Expected 3 cards of same rank are a set, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+
+	cards = append(cards, setupCard(gocard.SEVEN, gocard.SPADES))
+	if _, err := rules.NewMeld(cards); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as making set which has same suit twice"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestNewMeldRunWithJoker(t *testing.T) {
+	rules := Rules{}
+	cards := gocard.Cards{
+		setupCard(gocard.SIX, gocard.HEARTS),
+		Joker,
+		setupCard(gocard.FOUR, gocard.HEARTS),
+	}
+
+	meld, err := rules.NewMeld(cards)
+	if err != nil {
+		t.Fatalf("Couldn't make run with joker\nError: %v", err)
+	}
+	expected := gocard.Cards{setupCard(gocard.FOUR, gocard.HEARTS), Joker, setupCard(gocard.SIX, gocard.HEARTS)}
+	for i := range expected {
+		if meld.Type != RUN || meld.Cards[i] != expected[i] {
+			actual := meld
+			msg := "Expected joker substitutes Five of Hearts in run, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func TestNewMeldAceHigh(t *testing.T) {
+	cards := gocard.Cards{
+		setupCard(gocard.ACE, gocard.SPADES),
+		setupCard(gocard.QUEEN, gocard.SPADES),
+		setupCard(gocard.KING, gocard.SPADES),
+	}
+
+	if _, err := (Rules{}).NewMeld(cards); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as making Queen, King, Ace run without AceHigh"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if meld, err := (Rules{AceHigh: true}).NewMeld(cards); err != nil || meld.Cards[2].Rank != gocard.ACE {
+		expected := "Queen, King, Ace"
+		actual := meld
+		msg := "Expected Ace follows King in run with AceHigh, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+// #################################
+// Test Rules.Arrange()
+// #################################
+
+func TestArrange(t *testing.T) {
+	rules := Rules{}
+	hand := gocard.Cards{
+		setupCard(gocard.FIVE, gocard.SPADES),
+		setupCard(gocard.SIX, gocard.SPADES),
+		setupCard(gocard.SEVEN, gocard.SPADES),
+		setupCard(gocard.SEVEN, gocard.HEARTS),
+		setupCard(gocard.SEVEN, gocard.DIAMONDS),
+		setupCard(gocard.SEVEN, gocard.CLUBS),
+		setupCard(gocard.KING, gocard.HEARTS),
+		setupCard(gocard.QUEEN, gocard.DIAMONDS),
+		setupCard(gocard.TWO, gocard.CLUBS),
+		setupCard(gocard.THREE, gocard.CLUBS),
+	}
+
+	melds, deadwood, err := rules.Arrange(hand)
+	if err != nil {
+		t.Fatalf("Couldn't arrange hand\nError: %v", err)
+	}
+	if len(melds) != 2 || rules.Deadwood(deadwood) != 25 {
+		expected := "2 melds, deadwood 25"
+		actual := melds
+		msg := "Expected Seven of Spades is used in run, not in set of Sevens"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v %v", msg, expected, actual, deadwood)
+	}
+}
+
+func TestArrangeTooManyCards(t *testing.T) {
+	hand := gocard.Cards(gocard.NewDeck()[:17])
+	if _, _, err := (Rules{}).Arrange(hand); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as arranging too many cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,88 @@
+package rummy
+
+import (
+	"errors"
+	"fmt"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Round is a round of Rummy with stock and discard pile.
+// Each player draws a card from the stock or the discard pile, and then discards a card.
+type Round struct {
+	Stock gocard.Deck
+	// Discards is the discard pile, the last card is the top.
+	Discards gocard.Cards
+	Hands    []gocard.Cards
+	turn     int
+	drawn    bool
+}
+
+// NewRound deals size cards to each of players from deck, turns up a card to the discard pile,
+// and returns error of short deck.
+func NewRound(deck gocard.Deck, players int, size int) (round *Round, err error) {
+	if len(deck) < players*size+1 {
+		err = errors.New("couldn't deal, deck is short")
+		return round, err
+	}
+	round = &Round{Stock: deck, Hands: make([]gocard.Cards, players)}
+	for i := 0; i < size; i++ {
+		for player := range round.Hands {
+			card, _ := round.Stock.Draw()
+			round.Hands[player] = append(round.Hands[player], card)
+		}
+	}
+	card, _ := round.Stock.Draw()
+	round.Discards = gocard.Cards{card}
+	return round, err
+}
+
+// Turn returns the player to play.
+func (round *Round) Turn() (player int) {
+	return round.turn
+}
+
+// DrawStock draws a card from the stock to hand of the player, and returns error of empty stock.
+func (round *Round) DrawStock() (card gocard.Card, err error) {
+	if round.drawn {
+		err = errors.New("couldn't draw, already drawn in this turn")
+		return card, err
+	}
+	if card, err = round.Stock.Draw(); err != nil {
+		return card, err
+	}
+	round.Hands[round.turn] = append(round.Hands[round.turn], card)
+	round.drawn = true
+	return card, err
+}
+
+// DrawDiscard takes the top card of the discard pile to hand of the player, and returns error of empty pile.
+func (round *Round) DrawDiscard() (card gocard.Card, err error) {
+	if round.drawn {
+		err = errors.New("couldn't draw, already drawn in this turn")
+		return card, err
+	}
+	if len(round.Discards) == 0 {
+		err = errors.New("couldn't draw, discard pile is empty")
+		return card, err
+	}
+	card = round.Discards[len(round.Discards)-1]
+	round.Discards = round.Discards[:len(round.Discards)-1]
+	round.Hands[round.turn] = append(round.Hands[round.turn], card)
+	round.drawn = true
+	return card, err
+}
+
+// Discard discards card from hand of the player and ends the turn, and returns error of illegal discard.
+func (round *Round) Discard(card gocard.Card) (err error) {
+	if !round.drawn {
+		return errors.New("couldn't discard, draw a card first")
+	}
+	if err = round.Hands[round.turn].Remove(card); err != nil {
+		return fmt.Errorf("couldn't discard, %s is not in hand", card)
+	}
+	round.Discards = append(round.Discards, card)
+	round.turn = (round.turn + 1) % len(round.Hands)
+	round.drawn = false
+	return err
+}
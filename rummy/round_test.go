@@ -0,0 +1,64 @@
+package rummy
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test NewRound()
+// #################################
+
+func TestNewRound(t *testing.T) {
+	round, err := NewRound(gocard.NewDeck(), 2, 10)
+	if err != nil {
+		t.Fatalf("Couldn't make new round\nError: %v", err)
+	}
+	if len(round.Hands[0]) != 10 || len(round.Hands[1]) != 10 || len(round.Discards) != 1 || len(round.Stock) != 31 {
+		expected := "10, 10, 1, 31"
+		actual := []int{len(round.Hands[0]), len(round.Hands[1]), len(round.Discards), len(round.Stock)}
+		msg := "Cards are not dealt to hands, discard pile and stock"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Round.Discard()
+// #################################
+
+func TestDiscard(t *testing.T) {
+	round, _ := NewRound(gocard.NewDeck(), 2, 10)
+	top := round.Discards[0]
+
+	if err := round.Discard(round.Hands[0][0]); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as discarding before drawing"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if card, err := round.DrawDiscard(); err != nil || card != top {
+		expected := top
+		actual := card
+		msg := "Drawn card is not top of discard pile"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if _, err := round.DrawStock(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as drawing twice in a turn"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := round.Discard(round.Hands[1][0]); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as discarding card not in hand"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := round.Discard(top); err != nil || round.Turn() != 1 || len(round.Hands[0]) != 10 {
+		expected := 1
+		actual := round.Turn()
+		msg := "Turn doesn't pass to next player after discarding"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
@@ -0,0 +1,97 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// SafeDeck is a deck which is safe for concurrent use by multiple goroutines.
+type SafeDeck struct {
+	mu   sync.Mutex
+	deck Deck
+}
+
+// NewSafeDeck returns safe deck of copy of deck.
+func NewSafeDeck(deck Deck) (safe *SafeDeck) {
+	return &SafeDeck{deck: append(Deck{}, deck...)}
+}
+
+// Len returns number of cards in the deck.
+func (safe *SafeDeck) Len() (length int) {
+	safe.mu.Lock()
+	defer safe.mu.Unlock()
+	return len(safe.deck)
+}
+
+// Deck returns copy of cards in the deck.
+func (safe *SafeDeck) Deck() (deck Deck) {
+	safe.mu.Lock()
+	defer safe.mu.Unlock()
+	return append(Deck{}, safe.deck...)
+}
+
+// Shuffle shuffles the deck.
+func (safe *SafeDeck) Shuffle() {
+	safe.mu.Lock()
+	defer safe.mu.Unlock()
+	safe.deck.Shuffle()
+}
+
+// Draw draws card from the top of the deck and returns drawn card, error of empty deck.
+func (safe *SafeDeck) Draw() (card Card, err error) {
+	safe.mu.Lock()
+	defer safe.mu.Unlock()
+	return safe.deck.Draw()
+}
+
+// DrawN draws n cards from the top of the deck at once, and returns error if the deck has less than n cards.
+// No cards are drawn on error.
+func (safe *SafeDeck) DrawN(n int) (cards Cards, err error) {
+	safe.mu.Lock()
+	defer safe.mu.Unlock()
+	if n < 0 || n > len(safe.deck) {
+		err = fmt.Errorf("couldn't draw %d cards, deck has %d cards", n, len(safe.deck))
+		return cards, err
+	}
+	cards = append(Cards{}, safe.deck[:n]...)
+	safe.deck = safe.deck[n:]
+	return cards, err
+}
+
+// PutTop puts cards on the top of the deck at once. The first card of cards is the top.
+func (safe *SafeDeck) PutTop(cards ...Card) {
+	safe.mu.Lock()
+	defer safe.mu.Unlock()
+	safe.deck = append(append(Deck{}, cards...), safe.deck...)
+}
+
+// PutBottom puts cards on the bottom of the deck at once. The last card of cards is the bottom.
+func (safe *SafeDeck) PutBottom(cards ...Card) {
+	safe.mu.Lock()
+	defer safe.mu.Unlock()
+	safe.deck = append(safe.deck, cards...)
+}
+
+// Stream returns channel which streams cards drawn from the top of the deck to goroutines.
+// The channel is closed when the deck is empty or ctx is done.
+func (safe *SafeDeck) Stream(ctx context.Context) (cards <-chan Card) {
+	stream := make(chan Card)
+	go func() {
+		defer close(stream)
+		for {
+			card, err := safe.Draw()
+			if err != nil {
+				return
+			}
+			select {
+			case stream <- card:
+			case <-ctx.Done():
+				// Return the card which no one received.
+				safe.PutTop(card)
+				return
+			}
+		}
+	}()
+	return stream
+}
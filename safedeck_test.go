@@ -0,0 +1,133 @@
+package card
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// #################################
+// Test SafeDeck.Draw()
+// #################################
+
+func TestSafeDeckConcurrentDraw(t *testing.T) {
+	safe := NewSafeDeck(NewDeck())
+	safe.Shuffle()
+
+	var mu sync.Mutex
+	drawn := map[Card]int{}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				card, err := safe.Draw()
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				drawn[card]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(drawn) != 52 || safe.Len() != 0 {
+		expected := 52
+		actual := len(drawn)
+		msg := "Expected each card is drawn once by goroutines, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	for card, n := range drawn {
+		if n != 1 {
+			expected := 1
+			actual := n
+			msg := "Card is drawn more than once"
+			t.Fatalf("%s (%s)\nExpected: %v\nActual  : %v", msg, card, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test SafeDeck.DrawN()
+// #################################
+
+func TestSafeDeckDrawN(t *testing.T) {
+	safe := NewSafeDeck(NewDeck())
+
+	if cards, err := safe.DrawN(5); err != nil || len(cards) != 5 || safe.Len() != 47 {
+		expected := 5
+		actual := len(cards)
+		msg := "Couldn't draw 5 cards at once"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if _, err := safe.DrawN(48); err == nil || safe.Len() != 47 {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as drawing more cards than deck has"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test SafeDeck.PutTop(), SafeDeck.PutBottom()
+// #################################
+
+func TestSafeDeckPut(t *testing.T) {
+	safe := NewSafeDeck(Deck{{Rank: FIVE, Suit: CLUBS}})
+	safe.PutTop(Card{Rank: ACE, Suit: SPADES}, Card{Rank: TWO, Suit: SPADES})
+	safe.PutBottom(Card{Rank: KING, Suit: HEARTS})
+
+	deck := safe.Deck()
+	expected := Deck{{Rank: ACE, Suit: SPADES}, {Rank: TWO, Suit: SPADES}, {Rank: FIVE, Suit: CLUBS}, {Rank: KING, Suit: HEARTS}}
+	for i := range expected {
+		if deck[i] != expected[i] {
+			actual := deck
+			msg := "Cards are not put on the top and the bottom"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test SafeDeck.Stream()
+// #################################
+
+func TestSafeDeckStream(t *testing.T) {
+	safe := NewSafeDeck(NewDeck())
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := safe.Stream(ctx)
+
+	for i := 0; i < 10; i++ {
+		<-stream
+	}
+	cancel()
+	received := 10
+	for range stream {
+		received++
+	}
+
+	if n := safe.Len(); n != 52-received {
+		expected := 52 - received
+		actual := n
+		msg := "Expected cards not received remain in the deck after cancel, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestSafeDeckStreamToEnd(t *testing.T) {
+	safe := NewSafeDeck(NewDeck())
+	count := 0
+	for range safe.Stream(context.Background()) {
+		count++
+	}
+
+	if count != 52 {
+		expected := 52
+		actual := count
+		msg := "Expected stream sends all cards and closes, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Package scenario builds stacked decks for tests of game engines.
+
+A scenario states cards which are drawn first in short notation (e.g. "As Kd"),
+and the rest of the deck is filled deterministically.
+
+	deck, err := scenario.New().
+		Deal("As Kd", "6h 9c"). // player gets As Kd, dealer gets 6h 9c
+		Next("9h 2s").
+		Deck()
+*/
+package scenario
+
+import (
+	"fmt"
+	"math/rand"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Scenario is a builder of stacked deck.
+// Cards stated by Next and Deal are on the top in stated order, and cards stated by Bottom are on the bottom.
+// The first error in building is returned by Deck.
+type Scenario struct {
+	universe gocard.Deck
+	top      gocard.Cards
+	bottom   gocard.Cards
+	seed     *int64
+	err      error
+}
+
+// New returns scenario of a deck of 52 cards.
+func New() (scenario *Scenario) {
+	return From(gocard.NewDeck())
+}
+
+// From returns scenario of deck. Stated cards must be in deck.
+func From(deck gocard.Deck) (scenario *Scenario) {
+	return &Scenario{universe: append(gocard.Deck{}, deck...)}
+}
+
+// parse parses notations of cards and records the first error.
+func (scenario *Scenario) parse(notations string) (cards gocard.Cards) {
+	cards, err := gocard.ParseCards(notations)
+	if err != nil && scenario.err == nil {
+		scenario.err = err
+	}
+	return cards
+}
+
+// Next states cards drawn next from the top of the deck. (e.g. "9c 2s")
+func (scenario *Scenario) Next(notations string) (same *Scenario) {
+	scenario.top = append(scenario.top, scenario.parse(notations)...)
+	return scenario
+}
+
+// Deal states cards dealt next to hands one by one in turn.
+// Each argument is cards of a hand. (e.g. Deal("As Kd", "6h 9c") stacks As, 6h, Kd, 9c)
+func (scenario *Scenario) Deal(hands ...string) (same *Scenario) {
+	piles := make([]gocard.Deck, len(hands))
+	for i, hand := range hands {
+		piles[i] = gocard.Deck(scenario.parse(hand))
+	}
+	scenario.top = append(scenario.top, gocard.Merge(piles...)...)
+	return scenario
+}
+
+// Bottom states cards on the bottom of the deck. The last card of notations is the bottom.
+func (scenario *Scenario) Bottom(notations string) (same *Scenario) {
+	scenario.bottom = append(scenario.bottom, scenario.parse(notations)...)
+	return scenario
+}
+
+// Seed shuffles cards which are not stated with seed. Cards are kept in order of the deck without it.
+func (scenario *Scenario) Seed(seed int64) (same *Scenario) {
+	scenario.seed = &seed
+	return scenario
+}
+
+// Deck returns stacked deck, and returns error if some cards are invalid, stated twice or not in the deck.
+func (scenario *Scenario) Deck() (deck gocard.Deck, err error) {
+	if scenario.err != nil {
+		return deck, scenario.err
+	}
+	rest := append(gocard.Deck{}, scenario.universe...)
+	stated := append(append(gocard.Cards{}, scenario.top...), scenario.bottom...)
+	for _, card := range stated {
+		if err = rest.Remove(card); err != nil {
+			err = fmt.Errorf("couldn't stack %s, it is stated twice or not in deck", card)
+			return deck, err
+		}
+	}
+	if scenario.seed != nil {
+		rest.ShuffleWith(rand.New(rand.NewSource(*scenario.seed)))
+	}
+
+	deck = append(deck, scenario.top...)
+	deck = append(deck, rest...)
+	deck = append(deck, scenario.bottom...)
+	return deck, err
+}
@@ -0,0 +1,95 @@
+package scenario
+
+import (
+	"fmt"
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test Scenario.Deck()
+// #################################
+
+func TestDeck(t *testing.T) {
+	deck, err := New().Deal("As Kd", "6h 9c").Next("2s").Bottom("Qh").Deck()
+	if err != nil || len(deck) != 52 {
+		expected := 52
+		actual := len(deck)
+		msg := "Couldn't build deck of 52 cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+
+	expected, _ := gocard.ParseCards("As 6h Kd 9c 2s")
+	for i, card := range expected {
+		if deck[i] != card {
+			actual := deck[:len(expected)]
+			msg := "Stacked cards are not on the top in dealt order"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+	if bottom := deck[51]; bottom != (gocard.Card{Rank: gocard.QUEEN, Suit: gocard.HEARTS}) {
+		expected := gocard.Card{Rank: gocard.QUEEN, Suit: gocard.HEARTS}
+		actual := bottom
+		msg := "Bottom card is not stated card"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	// The rest follows order of new deck without stated cards, so Three of Spades comes next.
+	if next := deck[5]; next != (gocard.Card{Rank: gocard.THREE, Suit: gocard.SPADES}) {
+		expected := gocard.Card{Rank: gocard.THREE, Suit: gocard.SPADES}
+		actual := next
+		msg := "Rest of deck is not in order of new deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestDeckSeed(t *testing.T) {
+	deck1, _ := New().Next("As").Seed(1).Deck()
+	deck2, _ := New().Next("As").Seed(1).Deck()
+
+	for i := range deck1 {
+		if deck1[i] != deck2[i] {
+			expected := deck1
+			actual := deck2
+			msg := "Expected decks with same seed are same, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func TestDeckInvalidScenario(t *testing.T) {
+	scenarios := map[string]*Scenario{
+		"invalid notation": New().Next("As Zz"),
+		"same cards":       New().Next("As").Deal("Kd", "As"),
+		"card not in deck": From(gocard.Deck{{Rank: gocard.ACE, Suit: gocard.SPADES}}).Next("Kd"),
+	}
+	for name, scenario := range scenarios {
+		if _, err := scenario.Deck(); err == nil {
+			expected := "error"
+			actual := err
+			msg := "Couldn't catch error as building deck with " + name
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func ExampleScenario_Deck() {
+	deck, err := New().
+		Deal("As Kd", "6h 9c"). // player gets As Kd, dealer gets 6h 9c
+		Next("9h 2s").
+		Deck()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, card := range deck[:6] {
+		fmt.Println(card)
+	}
+	// Output:
+	// Ace of Spades
+	// Six of Hearts
+	// King of Diamonds
+	// Nine of Clubs
+	// Nine of Hearts
+	// Two of Spades
+}
@@ -0,0 +1,259 @@
+/*
+Package shuffletest tests quality of shuffles statistically.
+
+Each test runs a shuffle many times on a sorted deck and computes chi-square statistic
+of observed counts to counts expected from uniformly random permutations.
+*/
+package shuffletest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Shuffle is a function which shuffles deck.
+type Shuffle func(deck gocard.Deck)
+
+// Standard shuffles deck by Deck.Shuffle.
+func Standard(deck gocard.Deck) {
+	deck.Shuffle()
+}
+
+// Seeded returns shuffle by Deck.ShuffleWith with random source of seed. It is reproducible.
+func Seeded(seed int64) (shuffle Shuffle) {
+	r := rand.New(rand.NewSource(seed))
+	return func(deck gocard.Deck) {
+		deck.ShuffleWith(r)
+	}
+}
+
+// Naive returns biased shuffle which swaps each card with a card at any position.
+func Naive(seed int64) (shuffle Shuffle) {
+	r := rand.New(rand.NewSource(seed))
+	return func(deck gocard.Deck) {
+		for i := range deck {
+			j := r.Intn(len(deck))
+			deck[i], deck[j] = deck[j], deck[i]
+		}
+	}
+}
+
+// Riffle returns riffle shuffle repeated times times by Gilbert-Shannon-Reeds model.
+func Riffle(times int, seed int64) (shuffle Shuffle) {
+	r := rand.New(rand.NewSource(seed))
+	return func(deck gocard.Deck) {
+		for t := 0; t < times; t++ {
+			cut := 0
+			for range deck {
+				cut += r.Intn(2)
+			}
+			left := append(gocard.Deck{}, deck[:cut]...)
+			right := append(gocard.Deck{}, deck[cut:]...)
+			for i := range deck {
+				// Drop a card from a packet with probability proportional to its size.
+				if r.Intn(len(left)+len(right)) < len(left) {
+					deck[i], left = left[0], left[1:]
+				} else {
+					deck[i], right = right[0], right[1:]
+				}
+			}
+		}
+	}
+}
+
+// Result is result of a test.
+type Result struct {
+	Name             string
+	Statistic        float64
+	DegreesOfFreedom int
+	PValue           float64
+}
+
+// String returns string of result. (e.g. Position frequency: chi2=2650.12 df=2601 p=0.2455)
+func (result Result) String() (msg string) {
+	return fmt.Sprintf("%s: chi2=%.2f df=%d p=%.4f", result.Name, result.Statistic, result.DegreesOfFreedom, result.PValue)
+}
+
+// Passed returns whether the shuffle is not rejected as uniform at significance level alpha. (e.g. 0.001)
+func (result Result) Passed(alpha float64) (passed bool) {
+	return result.PValue >= alpha
+}
+
+// newResult returns result of chi-square test.
+func newResult(name string, observed []float64, expected []float64, df int) (result Result) {
+	result = Result{Name: name, Statistic: chiSquare(observed, expected), DegreesOfFreedom: df}
+	result.PValue = pValue(result.Statistic, df)
+	return result
+}
+
+// sortedDeck returns deck of size cards. Rank of each card is its position, so cards are identified by rank.
+func sortedDeck(size int) (deck gocard.Deck) {
+	for i := 0; i < size; i++ {
+		deck = append(deck, gocard.Card{Rank: gocard.Rank(i)})
+	}
+	return deck
+}
+
+// check returns error if parameters of test are invalid.
+func check(size int, runs int) (err error) {
+	if size < 2 || runs < 1 {
+		err = errors.New("couldn't test, size must be 2 or more and runs must be positive")
+	}
+	return err
+}
+
+// PositionFrequency tests frequency of each card at each position of size cards in runs shuffles.
+func PositionFrequency(shuffle Shuffle, size int, runs int) (result Result, err error) {
+	if err = check(size, runs); err != nil {
+		return result, err
+	}
+	observed := make([]float64, size*size)
+	for run := 0; run < runs; run++ {
+		deck := sortedDeck(size)
+		shuffle(deck)
+		for position, card := range deck {
+			observed[int(card.Rank)*size+position]++
+		}
+	}
+	expected := make([]float64, size*size)
+	for i := range expected {
+		expected[i] = float64(runs) / float64(size)
+	}
+	return newResult("Position frequency", observed, expected, (size-1)*(size-1)), err
+}
+
+// Adjacency tests how often each pair of neighbor cards in the sorted deck stays adjacent in same order.
+func Adjacency(shuffle Shuffle, size int, runs int) (result Result, err error) {
+	if err = check(size, runs); err != nil {
+		return result, err
+	}
+	// observed[i] is count of card i followed by card i+1, and the last category is the rest.
+	observed := make([]float64, size)
+	for run := 0; run < runs; run++ {
+		deck := sortedDeck(size)
+		shuffle(deck)
+		for i := 0; i+1 < size; i++ {
+			if deck[i+1].Rank == deck[i].Rank+1 {
+				observed[deck[i].Rank]++
+			}
+		}
+	}
+	// Each pair is adjacent in order with probability 1/size.
+	expected := make([]float64, size)
+	for i := 0; i+1 < size; i++ {
+		expected[i] = float64(runs) / float64(size)
+	}
+	observed, expected = observed[:size-1], expected[:size-1]
+	return newResult("Adjacency", observed, expected, size-1), err
+}
+
+// RisingSequences tests distribution of number of rising sequences in runs shuffles of size cards.
+// Riffle shuffles repeated a few times have too few rising sequences.
+func RisingSequences(shuffle Shuffle, size int, runs int) (result Result, err error) {
+	if err = check(size, runs); err != nil {
+		return result, err
+	}
+	observed := make([]float64, size)
+	position := make([]int, size)
+	for run := 0; run < runs; run++ {
+		deck := sortedDeck(size)
+		shuffle(deck)
+		for i, card := range deck {
+			position[card.Rank] = i
+		}
+		sequences := 1
+		for i := 0; i+1 < size; i++ {
+			if position[i+1] < position[i] {
+				sequences++
+			}
+		}
+		observed[sequences-1]++
+	}
+	// Probability of k+1 rising sequences is Eulerian number A(size, k) / size!.
+	eulerian := []float64{1}
+	for n := 2; n <= size; n++ {
+		next := make([]float64, n)
+		for k := 0; k < n; k++ {
+			if k < n-1 {
+				next[k] += float64(k+1) * eulerian[k]
+			}
+			if k > 0 {
+				next[k] += float64(n-k) * eulerian[k-1]
+			}
+		}
+		// Divide by n so that eulerian is probabilities.
+		for k := range next {
+			next[k] /= float64(n)
+		}
+		eulerian = next
+	}
+	expected := make([]float64, size)
+	for k := range expected {
+		expected[k] = eulerian[k] * float64(runs)
+	}
+	observed, expected = merge(observed, expected)
+	return newResult("Rising sequences", observed, expected, len(observed)-1), err
+}
+
+// Permutations tests distribution of all permutations of size (2 ~ 8) cards in runs shuffles.
+func Permutations(shuffle Shuffle, size int, runs int) (result Result, err error) {
+	if err = check(size, runs); err != nil {
+		return result, err
+	}
+	if size > 8 {
+		err = errors.New("couldn't test, size must be 8 or less for permutations")
+		return result, err
+	}
+	count := int(math.Round(math.Gamma(float64(size + 1))))
+	observed := make([]float64, count)
+	for run := 0; run < runs; run++ {
+		deck := sortedDeck(size)
+		shuffle(deck)
+		observed[lehmer(deck)]++
+	}
+	expected := make([]float64, count)
+	for i := range expected {
+		expected[i] = float64(runs) / float64(count)
+	}
+	return newResult("Permutations", observed, expected, count-1), err
+}
+
+// lehmer returns index of permutation of deck by Lehmer code.
+func lehmer(deck gocard.Deck) (index int) {
+	for i := range deck {
+		smaller := 0
+		for j := i + 1; j < len(deck); j++ {
+			if deck[j].Rank < deck[i].Rank {
+				smaller++
+			}
+		}
+		index = index*(len(deck)-i) + smaller
+	}
+	return index
+}
+
+// Run runs all tests of shuffle with runs shuffles, and returns results.
+// Permutations is tested with 5 cards and the others are tested with 52 cards.
+func Run(shuffle Shuffle, runs int) (results []Result, err error) {
+	tests := []struct {
+		test func(Shuffle, int, int) (Result, error)
+		size int
+	}{
+		{PositionFrequency, 52},
+		{Adjacency, 52},
+		{RisingSequences, 52},
+		{Permutations, 5},
+	}
+	for _, t := range tests {
+		result, err := t.test(shuffle, t.size, runs)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, err
+}
@@ -0,0 +1,91 @@
+package shuffletest
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Significance level of tests
+const alpha = 0.001
+
+// #################################
+// Test Run()
+// #################################
+
+func TestRunSeeded(t *testing.T) {
+	results, err := Run(Seeded(1), 2000)
+	if err != nil {
+		t.Fatalf("Couldn't run tests\nError: %v", err)
+	}
+	for _, result := range results {
+		if !result.Passed(alpha) {
+			expected := "passed"
+			actual := result
+			msg := "Expected Deck.ShuffleWith passes all tests, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+// #################################
+// Test Permutations()
+// #################################
+
+func TestPermutationsNaive(t *testing.T) {
+	result, err := Permutations(Naive(1), 3, 6000)
+	if err != nil || result.Passed(alpha) {
+		expected := "failed"
+		actual := result
+		msg := "Expected naive shuffle fails permutations test, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestPermutationsTooLarge(t *testing.T) {
+	if _, err := Permutations(Standard, 9, 1); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as testing permutations of 9 cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test RisingSequences()
+// #################################
+
+func TestRisingSequencesRiffle(t *testing.T) {
+	if result, _ := RisingSequences(Riffle(3, 1), 52, 1000); result.Passed(alpha) {
+		expected := "failed"
+		actual := result
+		msg := "Expected 3 riffle shuffles fail rising sequences test, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if result, _ := RisingSequences(Riffle(12, 1), 52, 1000); !result.Passed(alpha) {
+		expected := "passed"
+		actual := result
+		msg := "Expected 12 riffle shuffles pass rising sequences test, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test PositionFrequency(), Adjacency()
+// #################################
+
+func TestNoShuffle(t *testing.T) {
+	none := func(deck gocard.Deck) {}
+	if result, _ := PositionFrequency(none, 10, 100); result.Passed(alpha) {
+		expected := "failed"
+		actual := result
+		msg := "Expected no shuffle fails position frequency test, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if result, _ := Adjacency(none, 10, 100); result.Passed(alpha) {
+		expected := "failed"
+		actual := result
+		msg := "Expected no shuffle fails adjacency test, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
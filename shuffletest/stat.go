@@ -0,0 +1,85 @@
+package shuffletest
+
+import (
+	"math"
+)
+
+// chiSquare returns chi-square statistic of observed counts to expected counts.
+func chiSquare(observed []float64, expected []float64) (statistic float64) {
+	for i := range observed {
+		if expected[i] > 0 {
+			d := observed[i] - expected[i]
+			statistic += d * d / expected[i]
+		}
+	}
+	return statistic
+}
+
+// merge merges categories which expected counts are less than 5 into neighbors.
+func merge(observed []float64, expected []float64) (mergedObserved []float64, mergedExpected []float64) {
+	var o, e float64
+	for i := range observed {
+		o += observed[i]
+		e += expected[i]
+		if e >= 5 {
+			mergedObserved = append(mergedObserved, o)
+			mergedExpected = append(mergedExpected, e)
+			o, e = 0, 0
+		}
+	}
+	if n := len(mergedObserved); n > 0 {
+		mergedObserved[n-1] += o
+		mergedExpected[n-1] += e
+	}
+	return mergedObserved, mergedExpected
+}
+
+// pValue returns probability that chi-square statistic with df degrees of freedom is greater than x.
+// It is the regularized upper incomplete gamma function Q(df/2, x/2).
+func pValue(x float64, df int) (p float64) {
+	if df <= 0 {
+		return 1
+	}
+	a, x := float64(df)/2, x/2
+	if x <= 0 {
+		return 1
+	}
+	lgamma, _ := math.Lgamma(a)
+	if x < a+1 {
+		// Series of the lower incomplete gamma function
+		sum, term := 1/a, 1/a
+		for n := 1; n < 1000; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*1e-15 {
+				break
+			}
+		}
+		return 1 - sum*math.Exp(-x+a*math.Log(x)-lgamma)
+	}
+	// Continued fraction of the upper incomplete gamma function (Lentz's method)
+	tiny := 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < 1000; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-15 {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lgamma) * h
+}
@@ -0,0 +1,31 @@
+package shuffletest
+
+import (
+	"math"
+	"testing"
+)
+
+// #################################
+// Test pValue()
+// #################################
+
+func TestPValue(t *testing.T) {
+	tests := []struct {
+		x  float64
+		df int
+		p  float64
+	}{
+		{3.841, 1, 0.05},
+		{18.307, 10, 0.05},
+		{2.0, 2, math.Exp(-1)},
+		{124.342, 100, 0.05},
+	}
+	for _, test := range tests {
+		if p := pValue(test.x, test.df); math.Abs(p-test.p) > 1e-3 {
+			expected := test.p
+			actual := p
+			msg := "P-value of chi-square is not expected value"
+			t.Fatalf("%s (x=%v, df=%v)\nExpected: %v\nActual  : %v", msg, test.x, test.df, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package solitaire
+
+import (
+	"errors"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// FreeCell is a game of FreeCell. Empty cell is zero Card.
+type FreeCell struct {
+	Cells       [4]gocard.Card
+	Tableau     [8]Column
+	Foundations [4]gocard.Cards
+	history     []FreeCell
+}
+
+// NewFreeCell deals 52-card deck to tableau, and returns error if deck is invalid.
+func NewFreeCell(deck gocard.Deck) (game *FreeCell, err error) {
+	if len(deck) != 52 {
+		err = errors.New("couldn't deal, deck must have 52 cards")
+		return game, err
+	}
+	game = &FreeCell{}
+	for i, card := range deck {
+		game.Tableau[i%8].Cards = append(game.Tableau[i%8].Cards, card)
+	}
+	return game, err
+}
+
+// MicrosoftDeal returns deck of deal number of Microsoft FreeCell.
+// NewFreeCell(deck) deals the same tableau as the deal number, and returns error if number is invalid.
+func MicrosoftDeal(number int) (deck gocard.Deck, err error) {
+	if number < 1 || number > 1<<31-1 {
+		err = errors.New("couldn't deal, deal number must be in 1 ~ 2147483647")
+		return deck, err
+	}
+	// Cards are ordered by rank, and by suit Clubs, Diamonds, Hearts, Spades in same rank.
+	suits := []gocard.Suit{gocard.CLUBS, gocard.DIAMONDS, gocard.HEARTS, gocard.SPADES}
+	cards := make(gocard.Cards, 52)
+	for i := range cards {
+		cards[i] = gocard.Card{Rank: gocard.Rank(i/4 + 1), Suit: suits[i%4]}
+	}
+	seed := uint32(number)
+	for left := 52; left > 0; left-- {
+		seed = (seed*214013 + 2531011) & 0x7fffffff
+		r := int(seed>>16) % left
+		deck = append(deck, cards[r])
+		cards[r] = cards[left-1]
+	}
+	return deck, err
+}
+
+// clone returns copy of game without history.
+func (game *FreeCell) clone() (c FreeCell) {
+	c = FreeCell{Cells: game.Cells}
+	for i, column := range game.Tableau {
+		c.Tableau[i] = column.clone()
+	}
+	for i, foundation := range game.Foundations {
+		c.Foundations[i] = append(gocard.Cards{}, foundation...)
+	}
+	return c
+}
+
+// capacity returns max number of cards moved to tableau at a time with free cells and empty columns.
+func (game *FreeCell) capacity(toEmpty bool) (n int) {
+	n = 1
+	for _, cell := range game.Cells {
+		if cell == (gocard.Card{}) {
+			n++
+		}
+	}
+	for _, column := range game.Tableau {
+		if len(column.Cards) == 0 {
+			n *= 2
+		}
+	}
+	if toEmpty {
+		n /= 2
+	}
+	return n
+}
+
+// Moves returns legal moves. Cards are moved to the first empty cell.
+func (game *FreeCell) Moves() (moves []Move) {
+	for i, cell := range game.Cells {
+		if cell != (gocard.Card{}) && accepts(game.Foundations[foundationIndex(cell)], cell) {
+			moves = append(moves, Move{From: CELL, FromIndex: i, To: FOUNDATION, ToIndex: foundationIndex(cell), Count: 1})
+		}
+	}
+	for i, column := range game.Tableau {
+		if n := len(column.Cards); n > 0 && accepts(game.Foundations[foundationIndex(column.Cards[n-1])], column.Cards[n-1]) {
+			moves = append(moves, Move{From: TABLEAU, FromIndex: i, To: FOUNDATION, ToIndex: foundationIndex(column.Cards[n-1]), Count: 1})
+		}
+	}
+
+	for i, column := range game.Tableau {
+		length := sequence(column.Cards, alternate)
+		for j, to := range game.Tableau {
+			if i == j {
+				continue
+			}
+			if len(to.Cards) == 0 {
+				for count := 1; count <= length && count <= game.capacity(true); count++ {
+					moves = append(moves, Move{From: TABLEAU, FromIndex: i, To: TABLEAU, ToIndex: j, Count: count})
+				}
+				continue
+			}
+			top := to.Cards[len(to.Cards)-1]
+			for count := 1; count <= length && count <= game.capacity(false); count++ {
+				if alternate(top, column.Cards[len(column.Cards)-count]) {
+					moves = append(moves, Move{From: TABLEAU, FromIndex: i, To: TABLEAU, ToIndex: j, Count: count})
+				}
+			}
+		}
+	}
+
+	for i, cell := range game.Cells {
+		if cell == (gocard.Card{}) {
+			continue
+		}
+		for j, to := range game.Tableau {
+			if len(to.Cards) == 0 || alternate(to.Cards[len(to.Cards)-1], cell) {
+				moves = append(moves, Move{From: CELL, FromIndex: i, To: TABLEAU, ToIndex: j, Count: 1})
+			}
+		}
+	}
+	for i, cell := range game.Cells {
+		if cell != (gocard.Card{}) {
+			continue
+		}
+		for j, column := range game.Tableau {
+			if len(column.Cards) > 0 {
+				moves = append(moves, Move{From: TABLEAU, FromIndex: j, To: CELL, ToIndex: i, Count: 1})
+			}
+		}
+		break
+	}
+	return moves
+}
+
+// Apply applies move, and returns error if move is illegal.
+func (game *FreeCell) Apply(move Move) (err error) {
+	if !contains(game.Moves(), move) {
+		return illegal(move)
+	}
+	game.history = append(game.history, game.clone())
+	var cards gocard.Cards
+	if move.From == CELL {
+		cards = gocard.Cards{game.Cells[move.FromIndex]}
+		game.Cells[move.FromIndex] = gocard.Card{}
+	} else {
+		cards = take(&game.Tableau[move.FromIndex].Cards, move.Count)
+	}
+	switch move.To {
+	case CELL:
+		game.Cells[move.ToIndex] = cards[0]
+	case TABLEAU:
+		game.Tableau[move.ToIndex].Cards = append(game.Tableau[move.ToIndex].Cards, cards...)
+	case FOUNDATION:
+		game.Foundations[move.ToIndex] = append(game.Foundations[move.ToIndex], cards...)
+	}
+	return err
+}
+
+// Undo undoes the last move, and returns error if no moves are applied.
+func (game *FreeCell) Undo() (err error) {
+	if len(game.history) == 0 {
+		return errNoUndo
+	}
+	history := game.history[:len(game.history)-1]
+	*game = game.history[len(game.history)-1]
+	game.history = history
+	return err
+}
+
+// AutoMove moves cards to foundations while it is safe, and returns applied moves.
+func (game *FreeCell) AutoMove() (moves []Move) {
+	for moved := true; moved; {
+		moved = false
+		for _, move := range game.Moves() {
+			if move.To != FOUNDATION {
+				continue
+			}
+			var card gocard.Card
+			if move.From == CELL {
+				card = game.Cells[move.FromIndex]
+			} else {
+				cards := game.Tableau[move.FromIndex].Cards
+				card = cards[len(cards)-1]
+			}
+			if safe(game.Foundations, card) {
+				game.Apply(move)
+				moves = append(moves, move)
+				moved = true
+				break
+			}
+		}
+	}
+	return moves
+}
+
+// Won returns whether all cards are on foundations.
+func (game *FreeCell) Won() (ok bool) {
+	return won(game.Foundations)
+}
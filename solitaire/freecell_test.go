@@ -0,0 +1,102 @@
+package solitaire
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test MicrosoftDeal()
+// #################################
+
+func TestMicrosoftDeal(t *testing.T) {
+	deck, err := MicrosoftDeal(1)
+	if err != nil {
+		t.Fatalf("Couldn't make deal 1\nError: %v", err)
+	}
+	game, _ := NewFreeCell(deck)
+	expected := gocard.Cards{
+		{Rank: gocard.JACK, Suit: gocard.DIAMONDS},
+		{Rank: gocard.TWO, Suit: gocard.DIAMONDS},
+		{Rank: gocard.NINE, Suit: gocard.HEARTS},
+		{Rank: gocard.JACK, Suit: gocard.CLUBS},
+		{Rank: gocard.FIVE, Suit: gocard.DIAMONDS},
+		{Rank: gocard.SEVEN, Suit: gocard.HEARTS},
+		{Rank: gocard.SEVEN, Suit: gocard.CLUBS},
+		{Rank: gocard.FIVE, Suit: gocard.HEARTS},
+	}
+	for i, column := range game.Tableau {
+		if column.Cards[0] != expected[i] {
+			actual := column.Cards[0]
+			msg := "First row of deal 1 is not same as Microsoft FreeCell"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected[i], actual)
+		}
+	}
+}
+
+func TestMicrosoftDealInvalid(t *testing.T) {
+	if _, err := MicrosoftDeal(0); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as making deal 0"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test FreeCell.Moves()
+// #################################
+
+func TestFreeCellCapacity(t *testing.T) {
+	game := &FreeCell{}
+	game.Tableau[0].Cards = gocard.Cards{
+		{Rank: gocard.NINE, Suit: gocard.SPADES},
+		{Rank: gocard.EIGHT, Suit: gocard.HEARTS},
+		{Rank: gocard.SEVEN, Suit: gocard.CLUBS},
+	}
+	game.Tableau[1].Cards = gocard.Cards{{Rank: gocard.TEN, Suit: gocard.DIAMONDS}}
+	for i := 2; i < 8; i++ {
+		game.Tableau[i].Cards = gocard.Cards{{Rank: gocard.KING, Suit: gocard.Suit(i%4 + 1)}}
+	}
+	for i := 1; i < 4; i++ {
+		game.Cells[i] = gocard.Card{Rank: gocard.QUEEN, Suit: gocard.Suit(i + 1)}
+	}
+
+	move := Move{From: TABLEAU, FromIndex: 0, To: TABLEAU, ToIndex: 1, Count: 3}
+	if contains(game.Moves(), move) {
+		expected := false
+		actual := true
+		msg := "Expected 3 cards can't be moved with 1 free cell, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	game.Cells[1] = gocard.Card{}
+	if err := game.Apply(move); err != nil {
+		t.Fatalf("Couldn't move 3 cards with 2 free cells\nError: %v", err)
+	}
+}
+
+// #################################
+// Test Solve()
+// #################################
+
+func TestSolve(t *testing.T) {
+	deck, _ := MicrosoftDeal(1)
+	game, _ := NewFreeCell(deck)
+
+	moves, err := Solve(game, 100000)
+	if err != nil {
+		t.Fatalf("Couldn't solve deal 1\nError: %v", err)
+	}
+	for _, move := range moves {
+		if err := game.Apply(move); err != nil {
+			t.Fatalf("Solution has illegal move\nError: %v", err)
+		}
+	}
+	if !game.Won() {
+		expected := true
+		actual := game.Won()
+		msg := "Game is not won by solution"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,193 @@
+package solitaire
+
+import (
+	"errors"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Klondike is a game of Klondike.
+// Draw is number of cards turned from the stock to the waste at a time. (1 or 3)
+type Klondike struct {
+	Stock       gocard.Cards
+	Waste       gocard.Cards
+	Tableau     [7]Column
+	Foundations [4]gocard.Cards
+	Draw        int
+	history     []Klondike
+}
+
+// NewKlondike deals 52-card deck to tableau and the stock, and returns error if deck is invalid.
+func NewKlondike(deck gocard.Deck, draw int) (game *Klondike, err error) {
+	if len(deck) != 52 {
+		err = errors.New("couldn't deal, deck must have 52 cards")
+		return game, err
+	}
+	if draw < 1 {
+		err = errors.New("couldn't deal, draw must be positive")
+		return game, err
+	}
+	game = &Klondike{Draw: draw}
+	for row := 0; row < len(game.Tableau); row++ {
+		for i := row; i < len(game.Tableau); i++ {
+			card, _ := deck.Draw()
+			game.Tableau[i].Cards = append(game.Tableau[i].Cards, card)
+		}
+	}
+	for i := range game.Tableau {
+		game.Tableau[i].Hidden = i
+	}
+	game.Stock = stock(deck)
+	return game, err
+}
+
+// clone returns copy of game without history.
+func (game *Klondike) clone() (c Klondike) {
+	c = Klondike{
+		Stock: append(gocard.Cards{}, game.Stock...),
+		Waste: append(gocard.Cards{}, game.Waste...),
+		Draw:  game.Draw,
+	}
+	for i, column := range game.Tableau {
+		c.Tableau[i] = column.clone()
+	}
+	for i, foundation := range game.Foundations {
+		c.Foundations[i] = append(gocard.Cards{}, foundation...)
+	}
+	return c
+}
+
+// toTableau returns moves of cards to tableau.
+func (game *Klondike) toTableau(from Area, index int, cards gocard.Cards) (moves []Move) {
+	for count := 1; count <= len(cards); count++ {
+		card := cards[len(cards)-count]
+		for i, column := range game.Tableau {
+			if from == TABLEAU && i == index {
+				continue
+			}
+			if len(column.Cards) == 0 && card.Rank == gocard.KING ||
+				len(column.Cards) > 0 && alternate(column.Cards[len(column.Cards)-1], card) {
+				moves = append(moves, Move{From: from, FromIndex: index, To: TABLEAU, ToIndex: i, Count: count})
+			}
+		}
+	}
+	return moves
+}
+
+// toFoundation returns move of the top card of pile to foundation.
+func (game *Klondike) toFoundation(from Area, index int, pile gocard.Cards) (moves []Move) {
+	if len(pile) == 0 {
+		return moves
+	}
+	card := pile[len(pile)-1]
+	if accepts(game.Foundations[foundationIndex(card)], card) {
+		moves = append(moves, Move{From: from, FromIndex: index, To: FOUNDATION, ToIndex: foundationIndex(card), Count: 1})
+	}
+	return moves
+}
+
+// Moves returns legal moves.
+// Turning cards from the stock is a move from STOCK to WASTE, and
+// turning over the waste to the stock is a move from WASTE to STOCK.
+func (game *Klondike) Moves() (moves []Move) {
+	for i, column := range game.Tableau {
+		moves = append(moves, game.toFoundation(TABLEAU, i, column.Cards)...)
+	}
+	moves = append(moves, game.toFoundation(WASTE, 0, game.Waste)...)
+	if len(game.Waste) > 0 {
+		moves = append(moves, game.toTableau(WASTE, 0, game.Waste[len(game.Waste)-1:])...)
+	}
+	for i, column := range game.Tableau {
+		faceUp := column.FaceUp()
+		moves = append(moves, game.toTableau(TABLEAU, i, faceUp[len(faceUp)-sequence(faceUp, alternate):])...)
+	}
+	for i, foundation := range game.Foundations {
+		if len(foundation) > 0 {
+			moves = append(moves, game.toTableau(FOUNDATION, i, foundation[len(foundation)-1:])...)
+		}
+	}
+	switch {
+	case len(game.Stock) > 0:
+		count := game.Draw
+		if len(game.Stock) < count {
+			count = len(game.Stock)
+		}
+		moves = append(moves, Move{From: STOCK, To: WASTE, Count: count})
+	case len(game.Waste) > 0:
+		moves = append(moves, Move{From: WASTE, To: STOCK, Count: len(game.Waste)})
+	}
+	return moves
+}
+
+// pile returns pile of area.
+func (game *Klondike) pile(area Area, index int) (pile *gocard.Cards) {
+	switch area {
+	case WASTE:
+		return &game.Waste
+	case TABLEAU:
+		return &game.Tableau[index].Cards
+	default:
+		return &game.Foundations[index]
+	}
+}
+
+// Apply applies move, and returns error if move is illegal.
+func (game *Klondike) Apply(move Move) (err error) {
+	if !contains(game.Moves(), move) {
+		return illegal(move)
+	}
+	game.history = append(game.history, game.clone())
+	switch {
+	case move.From == STOCK:
+		for i := 0; i < move.Count; i++ {
+			game.Waste = append(game.Waste, take(&game.Stock, 1)...)
+		}
+	case move.To == STOCK:
+		game.Stock = stock(gocard.Deck(game.Waste))
+		game.Waste = nil
+	default:
+		cards := take(game.pile(move.From, move.FromIndex), move.Count)
+		to := game.pile(move.To, move.ToIndex)
+		*to = append(*to, cards...)
+	}
+	for i := range game.Tableau {
+		game.Tableau[i].flip()
+	}
+	return err
+}
+
+// Undo undoes the last move, and returns error if no moves are applied.
+func (game *Klondike) Undo() (err error) {
+	if len(game.history) == 0 {
+		return errNoUndo
+	}
+	history := game.history[:len(game.history)-1]
+	*game = game.history[len(game.history)-1]
+	game.history = history
+	return err
+}
+
+// AutoMove moves cards to foundations while it is safe, and returns applied moves.
+func (game *Klondike) AutoMove() (moves []Move) {
+	for moved := true; moved; {
+		moved = false
+		for _, move := range game.Moves() {
+			if move.To != FOUNDATION {
+				continue
+			}
+			pile := *game.pile(move.From, move.FromIndex)
+			if safe(game.Foundations, pile[len(pile)-1]) {
+				game.Apply(move)
+				moves = append(moves, move)
+				moved = true
+				break
+			}
+		}
+	}
+	return moves
+}
+
+// Won returns whether all cards are on foundations.
+func (game *Klondike) Won() (ok bool) {
+	return won(game.Foundations)
+}
@@ -0,0 +1,103 @@
+package solitaire
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test NewKlondike()
+// #################################
+
+func TestNewKlondike(t *testing.T) {
+	game, err := NewKlondike(gocard.NewDeck(), 3)
+	if err != nil {
+		t.Fatalf("Couldn't deal Klondike\nError: %v", err)
+	}
+	for i, column := range game.Tableau {
+		if len(column.Cards) != i+1 || column.Hidden != i {
+			expected := []int{i + 1, i}
+			actual := []int{len(column.Cards), column.Hidden}
+			msg := "Column is not dealt i+1 cards with 1 face-up card"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+	if len(game.Stock) != 24 {
+		expected := 24
+		actual := len(game.Stock)
+		msg := "Stock doesn't have rest of deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Klondike.Apply()
+// #################################
+
+func TestKlondikeDrawAndUndo(t *testing.T) {
+	game, _ := NewKlondike(gocard.NewDeck(), 3)
+	top := game.Stock[len(game.Stock)-1]
+
+	if err := game.Apply(Move{From: STOCK, To: WASTE, Count: 3}); err != nil || len(game.Waste) != 3 || game.Waste[0] != top {
+		expected := top
+		actual := game.Waste
+		msg := "Expected 3 cards are turned from the stock to the waste, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := game.Undo(); err != nil || len(game.Waste) != 0 || len(game.Stock) != 24 {
+		expected := 24
+		actual := len(game.Stock)
+		msg := "Expected undo returns cards to the stock, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if err := game.Undo(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as undoing without moves"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestKlondikeIllegalMove(t *testing.T) {
+	game, _ := NewKlondike(gocard.NewDeck(), 1)
+	if err := game.Apply(Move{From: TABLEAU, FromIndex: 0, To: TABLEAU, ToIndex: 1, Count: 1}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as applying illegal move"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestKlondikeFlip(t *testing.T) {
+	game := &Klondike{Draw: 1}
+	game.Tableau[0] = Column{Cards: gocard.Cards{{Rank: gocard.TWO, Suit: gocard.CLUBS}, {Rank: gocard.ACE, Suit: gocard.SPADES}}, Hidden: 1}
+
+	if err := game.Apply(Move{From: TABLEAU, To: FOUNDATION, Count: 1}); err != nil || game.Tableau[0].Hidden != 0 {
+		expected := 0
+		actual := game.Tableau[0].Hidden
+		msg := "Expected face-down card is turned up after moving the top card, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+// #################################
+// Test Klondike.AutoMove()
+// #################################
+
+func TestKlondikeAutoMove(t *testing.T) {
+	game := &Klondike{Draw: 1}
+	for i := range game.Foundations {
+		for rank := gocard.ACE; rank < gocard.QUEEN; rank++ {
+			game.Foundations[i] = append(game.Foundations[i], gocard.Card{Rank: rank, Suit: gocard.Suit(i + 1)})
+		}
+		game.Tableau[i].Cards = gocard.Cards{{Rank: gocard.KING, Suit: gocard.Suit(i + 1)}, {Rank: gocard.QUEEN, Suit: gocard.Suit(i + 1)}}
+	}
+
+	if moves := game.AutoMove(); len(moves) != 8 || !game.Won() {
+		expected := 8
+		actual := len(moves)
+		msg := "Expected all cards are moved to foundations, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Package solitaire implements Klondike, FreeCell and Spider solitaire.
+
+Every pile is a Cards which the last card is the top card.
+Games generate legal moves, apply and undo moves, and detect win.
+*/
+package solitaire
+
+import (
+	"errors"
+	"fmt"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Area is area of piles in solitaire. (STOCK, WASTE, TABLEAU, FOUNDATION, CELL)
+type Area int
+
+// These constant values are areas of piles.
+const (
+	STOCK Area = iota + 1
+	WASTE
+	TABLEAU
+	FOUNDATION
+	CELL
+)
+
+// String returns string of area. (e.g. Tableau)
+func (area Area) String() (msg string) {
+	switch area {
+	case STOCK:
+		return "Stock"
+	case WASTE:
+		return "Waste"
+	case TABLEAU:
+		return "Tableau"
+	case FOUNDATION:
+		return "Foundation"
+	case CELL:
+		return "Cell"
+	default:
+		return "Unknown"
+	}
+}
+
+// Move is a move of Count cards from a pile to a pile.
+// Index of foundation is suit of cards - 1. (e.g. Spades: 0)
+type Move struct {
+	From      Area
+	FromIndex int
+	To        Area
+	ToIndex   int
+	Count     int
+}
+
+// String returns string of move. (e.g. Tableau 1 -> Foundation 0 (1 cards))
+func (move Move) String() (msg string) {
+	return fmt.Sprintf("%s %d -> %s %d (%d cards)", move.From, move.FromIndex, move.To, move.ToIndex, move.Count)
+}
+
+// Column is a column of tableau. Hidden is number of face-down cards from the bottom.
+type Column struct {
+	Cards  gocard.Cards
+	Hidden int
+}
+
+// FaceUp returns face-up cards of column.
+func (column Column) FaceUp() (cards gocard.Cards) {
+	return column.Cards[column.Hidden:]
+}
+
+// flip turns up the top card if all cards of column are face down.
+func (column *Column) flip() {
+	if column.Hidden > 0 && column.Hidden == len(column.Cards) {
+		column.Hidden--
+	}
+}
+
+// clone returns copy of column.
+func (column Column) clone() (c Column) {
+	return Column{Cards: append(gocard.Cards{}, column.Cards...), Hidden: column.Hidden}
+}
+
+// isRed returns whether suit is red.
+func isRed(suit gocard.Suit) (red bool) {
+	return suit == gocard.HEARTS || suit == gocard.DIAMONDS
+}
+
+// alternate returns whether upper can be put on lower in tableau of Klondike and FreeCell.
+func alternate(lower gocard.Card, upper gocard.Card) (ok bool) {
+	return lower.Rank == upper.Rank+1 && isRed(lower.Suit) != isRed(upper.Suit)
+}
+
+// sameSuit returns whether upper is put on lower in a sequence of Spider.
+func sameSuit(lower gocard.Card, upper gocard.Card) (ok bool) {
+	return lower.Rank == upper.Rank+1 && lower.Suit == upper.Suit
+}
+
+// sequence returns number of cards on the top of cards which are in sequence by follows.
+func sequence(cards gocard.Cards, follows func(lower, upper gocard.Card) bool) (n int) {
+	if len(cards) == 0 {
+		return 0
+	}
+	n = 1
+	for i := len(cards) - 1; i > 0 && follows(cards[i-1], cards[i]); i-- {
+		n++
+	}
+	return n
+}
+
+// foundationIndex returns index of foundation for card.
+func foundationIndex(card gocard.Card) (index int) {
+	return int(card.Suit) - 1
+}
+
+// accepts returns whether card can be put on foundation.
+func accepts(foundation gocard.Cards, card gocard.Card) (ok bool) {
+	return int(card.Rank) == len(foundation)+1
+}
+
+// safe returns whether card can be moved to foundations without blocking any cards.
+// It is safe if both foundations of other color have cards one rank lower.
+func safe(foundations [4]gocard.Cards, card gocard.Card) (ok bool) {
+	if card.Rank <= gocard.TWO {
+		return true
+	}
+	for i, foundation := range foundations {
+		if isRed(gocard.Suit(i+1)) != isRed(card.Suit) && len(foundation) < int(card.Rank)-1 {
+			return false
+		}
+	}
+	return true
+}
+
+// won returns whether all cards are on foundations.
+func won(foundations [4]gocard.Cards) (ok bool) {
+	for _, foundation := range foundations {
+		if len(foundation) != 13 {
+			return false
+		}
+	}
+	return true
+}
+
+// contains returns whether move is in moves.
+func contains(moves []Move, move Move) (ok bool) {
+	for _, m := range moves {
+		if m == move {
+			return true
+		}
+	}
+	return false
+}
+
+// take removes count cards from the top of pile and returns them.
+func take(pile *gocard.Cards, count int) (cards gocard.Cards) {
+	cards = append(gocard.Cards{}, (*pile)[len(*pile)-count:]...)
+	*pile = (*pile)[:len(*pile)-count]
+	return cards
+}
+
+// stock returns cards of deck as a pile which the first card of deck is the top.
+func stock(deck gocard.Deck) (pile gocard.Cards) {
+	for i := len(deck) - 1; i >= 0; i-- {
+		pile = append(pile, deck[i])
+	}
+	return pile
+}
+
+// illegal returns error of illegal move.
+func illegal(move Move) (err error) {
+	return fmt.Errorf("couldn't move, %s is illegal", move)
+}
+
+// errNoUndo is error of undo without moves.
+var errNoUndo = errors.New("couldn't undo, no moves")
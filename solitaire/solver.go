@@ -0,0 +1,133 @@
+package solitaire
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// freeCellSolver is depth-first search of FreeCell with visited positions.
+type freeCellSolver struct {
+	visited map[string]bool
+	nodes   int
+	limit   int
+	path    []Move
+}
+
+// key returns string of position of game. Positions which differ in order of cells or columns have same key.
+func (game *FreeCell) key() (key string) {
+	var cells, columns []string
+	for _, cell := range game.Cells {
+		cells = append(cells, string(rune(cell.Suit*16)+rune(cell.Rank)+'0'))
+	}
+	for _, column := range game.Tableau {
+		var b strings.Builder
+		for _, card := range column.Cards {
+			b.WriteRune(rune(card.Suit*16) + rune(card.Rank) + '0')
+		}
+		columns = append(columns, b.String())
+	}
+	sort.Strings(cells)
+	sort.Strings(columns)
+	return strings.Join(cells, "") + "|" + strings.Join(columns, "|")
+}
+
+// heuristic returns estimated distance of game from win. Positions which have smaller distance are searched first.
+// It counts cards not on foundations, cards covering the next cards of foundations, and occupied cells.
+func (game *FreeCell) heuristic() (h int) {
+	for _, foundation := range game.Foundations {
+		h += 2 * (13 - len(foundation))
+	}
+	for _, column := range game.Tableau {
+		for i, card := range column.Cards {
+			if int(card.Rank) == len(game.Foundations[foundationIndex(card)])+1 {
+				h += len(column.Cards) - 1 - i
+			}
+		}
+		if len(column.Cards) == 0 {
+			h -= 2
+		}
+	}
+	for _, cell := range game.Cells {
+		if cell != (gocard.Card{}) {
+			h++
+		}
+	}
+	return h
+}
+
+// useless returns whether move never makes progress. (e.g. moving a whole column to an empty column)
+func (game *FreeCell) useless(move Move) (ok bool) {
+	return move.From == TABLEAU && move.To == TABLEAU &&
+		len(game.Tableau[move.ToIndex].Cards) == 0 && len(game.Tableau[move.FromIndex].Cards) == move.Count
+}
+
+// byDistance is moves sorted by distances of positions after moves.
+type byDistance struct {
+	moves     []Move
+	distances []int
+}
+
+func (b byDistance) Len() int           { return len(b.moves) }
+func (b byDistance) Less(i, j int) bool { return b.distances[i] < b.distances[j] }
+func (b byDistance) Swap(i, j int) {
+	b.moves[i], b.moves[j] = b.moves[j], b.moves[i]
+	b.distances[i], b.distances[j] = b.distances[j], b.distances[i]
+}
+
+// search returns whether game is solved from current position.
+func (s *freeCellSolver) search(game *FreeCell) (solved bool) {
+	if game.Won() {
+		return true
+	}
+	key := game.key()
+	if s.visited[key] || s.nodes >= s.limit {
+		return false
+	}
+	s.visited[key] = true
+	s.nodes++
+
+	var moves []Move
+	var distances []int
+	for _, move := range game.Moves() {
+		if game.useless(move) {
+			continue
+		}
+		game.Apply(move)
+		auto := game.AutoMove()
+		moves = append(moves, move)
+		distances = append(distances, game.heuristic())
+		for i := 0; i <= len(auto); i++ {
+			game.Undo()
+		}
+	}
+	sort.Stable(byDistance{moves, distances})
+	for _, move := range moves {
+		game.Apply(move)
+		auto := game.AutoMove()
+		s.path = append(append(s.path, move), auto...)
+		if s.search(game) {
+			return true
+		}
+		s.path = s.path[:len(s.path)-1-len(auto)]
+		for i := 0; i <= len(auto); i++ {
+			game.Undo()
+		}
+	}
+	return false
+}
+
+// Solve returns moves which win game from current position, and returns error if
+// no solution is found in limit positions. It doesn't change game.
+func Solve(game *FreeCell, limit int) (moves []Move, err error) {
+	c := game.clone()
+	s := &freeCellSolver{visited: map[string]bool{}, limit: limit}
+	s.path = c.AutoMove()
+	if !s.search(&c) {
+		err = errors.New("couldn't solve, no solution is found")
+		return moves, err
+	}
+	return s.path, err
+}
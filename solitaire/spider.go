@@ -0,0 +1,140 @@
+package solitaire
+
+import (
+	"errors"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Spider is a game of Spider. Foundations are completed sequences from King to Ace.
+type Spider struct {
+	Stock       gocard.Cards
+	Tableau     [10]Column
+	Foundations []gocard.Cards
+	history     []Spider
+}
+
+// SpiderDeck returns 104-card deck of Spider with suits (1, 2 or 4) suits, and returns error if suits is invalid.
+func SpiderDeck(suits int) (deck gocard.Deck, err error) {
+	var use []gocard.Suit
+	switch suits {
+	case 1:
+		use = []gocard.Suit{gocard.SPADES}
+	case 2:
+		use = []gocard.Suit{gocard.SPADES, gocard.HEARTS}
+	case 4:
+		use = []gocard.Suit{gocard.SPADES, gocard.HEARTS, gocard.DIAMONDS, gocard.CLUBS}
+	default:
+		err = errors.New("couldn't make deck, suits must be 1, 2 or 4")
+		return deck, err
+	}
+	for i := 0; i < 8/len(use); i++ {
+		for _, suit := range use {
+			for rank := gocard.ACE; rank <= gocard.KING; rank++ {
+				deck = append(deck, gocard.Card{Rank: rank, Suit: suit})
+			}
+		}
+	}
+	return deck, err
+}
+
+// NewSpider deals 104-card deck to tableau and the stock, and returns error if deck is invalid.
+func NewSpider(deck gocard.Deck) (game *Spider, err error) {
+	if len(deck) != 104 {
+		err = errors.New("couldn't deal, deck must have 104 cards")
+		return game, err
+	}
+	game = &Spider{}
+	for i := 0; i < 54; i++ {
+		card, _ := deck.Draw()
+		game.Tableau[i%10].Cards = append(game.Tableau[i%10].Cards, card)
+	}
+	for i := range game.Tableau {
+		game.Tableau[i].Hidden = len(game.Tableau[i].Cards) - 1
+	}
+	game.Stock = stock(deck)
+	return game, err
+}
+
+// clone returns copy of game without history.
+func (game *Spider) clone() (c Spider) {
+	c = Spider{Stock: append(gocard.Cards{}, game.Stock...)}
+	for i, column := range game.Tableau {
+		c.Tableau[i] = column.clone()
+	}
+	for _, foundation := range game.Foundations {
+		c.Foundations = append(c.Foundations, append(gocard.Cards{}, foundation...))
+	}
+	return c
+}
+
+// Moves returns legal moves.
+// Dealing a card from the stock to each column is a move from STOCK to TABLEAU, and
+// it is legal only if no column is empty.
+func (game *Spider) Moves() (moves []Move) {
+	for i, column := range game.Tableau {
+		faceUp := column.FaceUp()
+		length := sequence(faceUp, sameSuit)
+		for j, to := range game.Tableau {
+			if i == j {
+				continue
+			}
+			for count := 1; count <= length; count++ {
+				if len(to.Cards) == 0 || to.Cards[len(to.Cards)-1].Rank == faceUp[len(faceUp)-count].Rank+1 {
+					moves = append(moves, Move{From: TABLEAU, FromIndex: i, To: TABLEAU, ToIndex: j, Count: count})
+				}
+			}
+		}
+	}
+	if len(game.Stock) > 0 {
+		for _, column := range game.Tableau {
+			if len(column.Cards) == 0 {
+				return moves
+			}
+		}
+		moves = append(moves, Move{From: STOCK, To: TABLEAU, Count: len(game.Tableau)})
+	}
+	return moves
+}
+
+// Apply applies move, and returns error if move is illegal.
+// Completed sequences from King to Ace are moved to foundations automatically.
+func (game *Spider) Apply(move Move) (err error) {
+	if !contains(game.Moves(), move) {
+		return illegal(move)
+	}
+	game.history = append(game.history, game.clone())
+	if move.From == STOCK {
+		for i := range game.Tableau {
+			game.Tableau[i].Cards = append(game.Tableau[i].Cards, take(&game.Stock, 1)...)
+		}
+	} else {
+		cards := take(&game.Tableau[move.FromIndex].Cards, move.Count)
+		game.Tableau[move.ToIndex].Cards = append(game.Tableau[move.ToIndex].Cards, cards...)
+	}
+	for i := range game.Tableau {
+		column := &game.Tableau[i]
+		column.flip()
+		if faceUp := column.FaceUp(); sequence(faceUp, sameSuit) >= 13 && faceUp[len(faceUp)-13].Rank == gocard.KING {
+			game.Foundations = append(game.Foundations, take(&column.Cards, 13))
+			column.flip()
+		}
+	}
+	return err
+}
+
+// Undo undoes the last move, and returns error if no moves are applied.
+func (game *Spider) Undo() (err error) {
+	if len(game.history) == 0 {
+		return errNoUndo
+	}
+	history := game.history[:len(game.history)-1]
+	*game = game.history[len(game.history)-1]
+	game.history = history
+	return err
+}
+
+// Won returns whether all 8 sequences are completed.
+func (game *Spider) Won() (ok bool) {
+	return len(game.Foundations) == 8
+}
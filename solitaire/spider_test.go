@@ -0,0 +1,89 @@
+package solitaire
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test NewSpider()
+// #################################
+
+func TestNewSpider(t *testing.T) {
+	deck, err := SpiderDeck(2)
+	if err != nil {
+		t.Fatalf("Couldn't make deck of Spider\nError: %v", err)
+	}
+	game, err := NewSpider(deck)
+	if err != nil {
+		t.Fatalf("Couldn't deal Spider\nError: %v", err)
+	}
+	for i, column := range game.Tableau {
+		size := 5
+		if i < 4 {
+			size = 6
+		}
+		if len(column.Cards) != size || len(column.FaceUp()) != 1 {
+			expected := size
+			actual := len(column.Cards)
+			msg := "Column is not dealt cards with 1 face-up card"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+	if len(game.Stock) != 50 {
+		expected := 50
+		actual := len(game.Stock)
+		msg := "Stock doesn't have rest of deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestSpiderDeckInvalid(t *testing.T) {
+	if _, err := SpiderDeck(3); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as making deck of 3 suits"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Spider.Apply()
+// #################################
+
+func TestSpiderComplete(t *testing.T) {
+	game := &Spider{}
+	for rank := gocard.KING; rank > gocard.ACE; rank-- {
+		game.Tableau[0].Cards = append(game.Tableau[0].Cards, gocard.Card{Rank: rank, Suit: gocard.HEARTS})
+	}
+	game.Tableau[0].Cards = append(gocard.Cards{{Rank: gocard.FIVE, Suit: gocard.CLUBS}}, game.Tableau[0].Cards...)
+	game.Tableau[0].Hidden = 1
+	game.Tableau[1].Cards = gocard.Cards{{Rank: gocard.ACE, Suit: gocard.HEARTS}}
+
+	if err := game.Apply(Move{From: TABLEAU, FromIndex: 1, To: TABLEAU, ToIndex: 0, Count: 1}); err != nil || len(game.Foundations) != 1 {
+		expected := 1
+		actual := len(game.Foundations)
+		msg := "Expected sequence from King to Ace is completed, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if column := game.Tableau[0]; len(column.Cards) != 1 || column.Hidden != 0 {
+		expected := 0
+		actual := column.Hidden
+		msg := "Expected card under completed sequence is turned up, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestSpiderDealWithEmptyColumn(t *testing.T) {
+	deck, _ := SpiderDeck(1)
+	game, _ := NewSpider(deck)
+	game.Tableau[9] = Column{}
+
+	if err := game.Apply(Move{From: STOCK, To: TABLEAU, Count: 10}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as dealing with empty column"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,99 @@
+package card
+
+import (
+	"errors"
+)
+
+// NOTRUMP is a trump suit of TrickRule for no-trump contracts.
+const NOTRUMP Suit = 0
+
+// TrickRule is a rule to decide the winner of a trick in trick-taking games.
+// Trump is the trump suit. (NOTRUMP means no trump)
+// If Bowers is true, the Jack of trump (right bower) and the Jack of same color (left bower)
+// are highest trumps like Euchre.
+type TrickRule struct {
+	Trump  Suit
+	Bowers bool
+}
+
+// sameColorSuit returns the other suit of same color. (e.g. Spades => Clubs)
+func sameColorSuit(suit Suit) (other Suit) {
+	switch suit {
+	case SPADES:
+		return CLUBS
+	case CLUBS:
+		return SPADES
+	case HEARTS:
+		return DIAMONDS
+	case DIAMONDS:
+		return HEARTS
+	default:
+		return suit
+	}
+}
+
+// isRightBower returns whether card is the Jack of trump.
+func (rule TrickRule) isRightBower(card Card) (right bool) {
+	return rule.Bowers && rule.Trump != NOTRUMP && card.Rank == JACK && card.Suit == rule.Trump
+}
+
+// isLeftBower returns whether card is the Jack of same color as trump.
+func (rule TrickRule) isLeftBower(card Card) (left bool) {
+	return rule.Bowers && rule.Trump != NOTRUMP && card.Rank == JACK && card.Suit == sameColorSuit(rule.Trump)
+}
+
+// EffectiveSuit returns suit of card in the trick.
+// It is trump suit for the left bower, otherwise it is suit of card.
+func (rule TrickRule) EffectiveSuit(card Card) (suit Suit) {
+	if rule.isLeftBower(card) {
+		return rule.Trump
+	}
+	return card.Suit
+}
+
+// LedSuit returns suit led to the trick by the first card of cards.
+func (rule TrickRule) LedSuit(cards Cards) (led Suit, err error) {
+	if len(cards) == 0 {
+		err = errors.New("couldn't decide led suit, trick is empty")
+		return led, err
+	}
+	return rule.EffectiveSuit(cards[0]), err
+}
+
+// power returns strength of card in the trick. Card which follows neither led suit nor trump has 0.
+func (rule TrickRule) power(led Suit, card Card) (power int) {
+	switch {
+	case rule.isRightBower(card):
+		return 302
+	case rule.isLeftBower(card):
+		return 301
+	case rule.Trump != NOTRUMP && card.Suit == rule.Trump:
+		return 200 + rankingOfRanks[card.Rank]
+	case card.Suit == led:
+		return 100 + rankingOfRanks[card.Rank]
+	default:
+		return 0
+	}
+}
+
+// Compare compares two cards played to a trick which led suit is led and returns diff of cards.
+// Trump beats other suits, and card of led suit beats card of other suits.
+// Return diff > 0 (card1 > card2), diff = 0 (card1 == card2), diff < 0 (card1 < card2)
+func (rule TrickRule) Compare(led Suit, card1 Card, card2 Card) (diff int) {
+	return rule.power(led, card1) - rule.power(led, card2)
+}
+
+// Winner returns index of the winning card in cards played to a trick which led suit is led.
+// If some cards are same strength, the card played first wins.
+func (rule TrickRule) Winner(led Suit, cards Cards) (index int, err error) {
+	if len(cards) == 0 {
+		err = errors.New("couldn't decide winner, trick is empty")
+		return index, err
+	}
+	for i, card := range cards {
+		if rule.Compare(led, card, cards[index]) > 0 {
+			index = i
+		}
+	}
+	return index, err
+}
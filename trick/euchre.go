@@ -0,0 +1,69 @@
+package trick
+
+import (
+	gocard "github.com/x-color/gocard"
+)
+
+// Euchre is rules of partnership Euchre for 4 players with 24 cards. (Nine ~ Ace)
+// Trump is the trump suit and Makers is the team which named trump.
+// The Jack of trump (right bower) and the Jack of same color (left bower) are highest trumps.
+type Euchre struct {
+	Trump  gocard.Suit
+	Makers int
+}
+
+// Players returns number of players of Euchre.
+func (*Euchre) Players() (players int) {
+	return 4
+}
+
+// HandSize returns number of cards dealt to each player.
+func (*Euchre) HandSize() (size int) {
+	return 5
+}
+
+// NewDeck returns a deck of 24 cards. (Nine ~ Ace)
+func (*Euchre) NewDeck() (deck gocard.Deck) {
+	return newDeck(gocard.NINE, gocard.TEN, gocard.JACK, gocard.QUEEN, gocard.KING, gocard.ACE)
+}
+
+// TrickRule returns rule with trump and bowers.
+func (rules *Euchre) TrickRule(game *Game) (rule gocard.TrickRule) {
+	return gocard.TrickRule{Trump: rules.Trump, Bowers: true}
+}
+
+// Leader returns seat on the left of the dealer.
+func (*Euchre) Leader(game *Game) (seat int) {
+	return game.Left(game.Dealer)
+}
+
+// CanPlay returns nil, Euchre has no rule except following suit.
+func (*Euchre) CanPlay(game *Game, seat int, card gocard.Card) (err error) {
+	return err
+}
+
+// Score returns score of team of each seat.
+// Makers score 1 point for 3 or 4 tricks and 2 points for all 5 tricks (march).
+// If makers take less than 3 tricks (euchred), defenders score 2 points.
+func (rules *Euchre) Score(game *Game) (scores []int) {
+	tricks := 0
+	for seat := 0; seat < 4; seat++ {
+		if Team(seat) == rules.Makers {
+			tricks += game.Taken(seat)
+		}
+	}
+	var teams [2]int
+	switch {
+	case tricks == 5:
+		teams[rules.Makers] = 2
+	case tricks >= 3:
+		teams[rules.Makers] = 1
+	default:
+		teams[1-rules.Makers] = 2
+	}
+	scores = make([]int, 4)
+	for seat := range scores {
+		scores[seat] = teams[Team(seat)]
+	}
+	return scores
+}
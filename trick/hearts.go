@@ -0,0 +1,100 @@
+package trick
+
+import (
+	"errors"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Hearts is rules of Hearts for 4 players.
+// The holder of Two of Clubs leads the first trick, and Hearts can't be led until broken.
+// Each Heart scores 1 point and Queen of Spades scores 13 points. Lower score is better.
+type Hearts struct{}
+
+var twoOfClubs = gocard.Card{Rank: gocard.TWO, Suit: gocard.CLUBS}
+
+var queenOfSpades = gocard.Card{Rank: gocard.QUEEN, Suit: gocard.SPADES}
+
+// Players returns number of players of Hearts.
+func (Hearts) Players() (players int) {
+	return 4
+}
+
+// HandSize returns number of cards dealt to each player.
+func (Hearts) HandSize() (size int) {
+	return 13
+}
+
+// NewDeck returns a deck of 52 cards.
+func (Hearts) NewDeck() (deck gocard.Deck) {
+	return gocard.NewDeck()
+}
+
+// TrickRule returns no-trump rule.
+func (Hearts) TrickRule(game *Game) (rule gocard.TrickRule) {
+	return gocard.TrickRule{Trump: gocard.NOTRUMP}
+}
+
+// Leader returns seat of the player who has Two of Clubs.
+func (Hearts) Leader(game *Game) (seat int) {
+	for seat, hand := range game.Hands {
+		if hand.Contains(twoOfClubs) {
+			return seat
+		}
+	}
+	return game.Left(game.Dealer)
+}
+
+// CanPlay returns error if player at seat couldn't play card.
+func (Hearts) CanPlay(game *Game, seat int, card gocard.Card) (err error) {
+	hand := game.Hands[seat]
+	firstTrick := len(game.Tricks) == 0
+	if len(game.Current.Cards) == 0 {
+		if firstTrick && hand.Contains(twoOfClubs) && card != twoOfClubs {
+			return errors.New("couldn't play, first trick must be led with Two of Clubs")
+		}
+		if card.Suit == gocard.HEARTS && !game.Broken(gocard.HEARTS) && !every(hand, func(c gocard.Card) bool {
+			return c.Suit == gocard.HEARTS
+		}) {
+			return errors.New("couldn't lead Hearts, Hearts is not broken")
+		}
+		return err
+	}
+	if firstTrick && heartsPoint(card) > 0 && !every(hand, func(c gocard.Card) bool {
+		return heartsPoint(c) > 0
+	}) {
+		return errors.New("couldn't play point card on the first trick")
+	}
+	return err
+}
+
+// Score returns points taken by each seat. If a player takes all 26 points, others take 26 points instead.
+func (Hearts) Score(game *Game) (scores []int) {
+	scores = make([]int, 4)
+	for seat := range scores {
+		for _, card := range game.Won(seat) {
+			scores[seat] += heartsPoint(card)
+		}
+	}
+	for seat, score := range scores {
+		if score == 26 {
+			for other := range scores {
+				scores[other] = 26
+			}
+			scores[seat] = 0
+			break
+		}
+	}
+	return scores
+}
+
+func heartsPoint(card gocard.Card) (point int) {
+	switch {
+	case card.Suit == gocard.HEARTS:
+		return 1
+	case card == queenOfSpades:
+		return 13
+	default:
+		return 0
+	}
+}
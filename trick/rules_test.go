@@ -0,0 +1,129 @@
+package trick
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// #################################
+// Test Hearts
+// #################################
+
+func TestHeartsCouldNotLeadHearts(t *testing.T) {
+	game := setupGame(Hearts{}, 0,
+		gocard.Cards{{Rank: gocard.ACE, Suit: gocard.HEARTS}, {Rank: gocard.ACE, Suit: gocard.CLUBS}},
+		gocard.Cards{{Rank: gocard.TWO, Suit: gocard.HEARTS}},
+		gocard.Cards{{Rank: gocard.THREE, Suit: gocard.HEARTS}},
+		gocard.Cards{{Rank: gocard.FOUR, Suit: gocard.HEARTS}},
+	)
+	game.Tricks = []Trick{{}}
+
+	if err := game.Play(0, gocard.Card{Rank: gocard.ACE, Suit: gocard.HEARTS}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as leading Hearts before broken"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestHeartsShootTheMoon(t *testing.T) {
+	game := NewGame(Hearts{}, 0)
+	for _, card := range gocard.NewDeck() {
+		game.Tricks = append(game.Tricks, Trick{Cards: gocard.Cards{card}, Winner: 2})
+	}
+
+	scores := Hearts{}.Score(game)
+	if scores[2] != 0 || scores[0] != 26 || scores[1] != 26 || scores[3] != 26 {
+		expected := []int{26, 26, 0, 26}
+		actual := scores
+		msg := "Expected other players take 26 points, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Spades
+// #################################
+
+func TestSpadesScore(t *testing.T) {
+	game := NewGame(&Spades{}, 0)
+	for i := 0; i < 13; i++ {
+		winner := 0
+		if i >= 9 {
+			winner = 1
+		}
+		game.Tricks = append(game.Tricks, Trick{Winner: winner})
+	}
+
+	rules := &Spades{Bids: [4]int{4, 5, 3, 0}}
+	scores := rules.Score(game)
+	if scores[0] != 72 || scores[1] != 50 {
+		expected := []int{72, 50, 72, 50}
+		actual := scores
+		msg := "Score of Spades is not expected score"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Euchre
+// #################################
+
+func TestEuchreLeftBowerFollowsTrump(t *testing.T) {
+	rules := &Euchre{Trump: gocard.SPADES}
+	game := setupGame(rules, 0,
+		gocard.Cards{{Rank: gocard.NINE, Suit: gocard.SPADES}},
+		gocard.Cards{{Rank: gocard.JACK, Suit: gocard.CLUBS}, {Rank: gocard.ACE, Suit: gocard.CLUBS}},
+		gocard.Cards{{Rank: gocard.TEN, Suit: gocard.HEARTS}},
+		gocard.Cards{{Rank: gocard.ACE, Suit: gocard.SPADES}},
+	)
+	game.Play(0, gocard.Card{Rank: gocard.NINE, Suit: gocard.SPADES})
+
+	if legal := game.Legal(1); len(legal) != 1 || legal[0].Rank != gocard.JACK {
+		expected := gocard.Cards{{Rank: gocard.JACK, Suit: gocard.CLUBS}}
+		actual := legal
+		msg := "Expected the left bower must follow trump, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestEuchreTurnUp(t *testing.T) {
+	game := NewGame(&Euchre{}, 0)
+	deck := game.Rules.NewDeck()
+	deck.Shuffle()
+	if err := game.Deal(&deck); err != nil {
+		t.Fatalf("Couldn't deal\nError: %v", err)
+	}
+
+	if len(deck) != 4 || game.TurnUp != deck[0] {
+		expected := deck
+		actual := game.TurnUp
+		msg := "Expected turn-up is the top card of the kitty, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	for seat, hand := range game.Hands {
+		if hand.Contains(game.TurnUp) {
+			expected := "not in hands"
+			actual := seat
+			msg := "Turn-up is in hand of a player"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func TestEuchreScoreEuchred(t *testing.T) {
+	game := NewGame(&Euchre{}, 0)
+	for _, winner := range []int{0, 1, 2, 3, 1} {
+		game.Tricks = append(game.Tricks, Trick{Winner: winner})
+	}
+
+	rules := &Euchre{Trump: gocard.HEARTS, Makers: 0}
+	scores := rules.Score(game)
+	if scores[0] != 0 || scores[1] != 2 {
+		expected := []int{0, 2, 0, 2}
+		actual := scores
+		msg := "Expected defenders score 2 points, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
@@ -0,0 +1,79 @@
+package trick
+
+import (
+	"errors"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Spades is rules of partnership Spades for 4 players. Spades is always trump and can't be led until broken.
+// Bids is number of tricks bid by each seat, and bid 0 means Nil.
+type Spades struct {
+	Bids [4]int
+}
+
+// Players returns number of players of Spades.
+func (*Spades) Players() (players int) {
+	return 4
+}
+
+// HandSize returns number of cards dealt to each player.
+func (*Spades) HandSize() (size int) {
+	return 13
+}
+
+// NewDeck returns a deck of 52 cards.
+func (*Spades) NewDeck() (deck gocard.Deck) {
+	return gocard.NewDeck()
+}
+
+// TrickRule returns rule which Spades is trump.
+func (*Spades) TrickRule(game *Game) (rule gocard.TrickRule) {
+	return gocard.TrickRule{Trump: gocard.SPADES}
+}
+
+// Leader returns seat on the left of the dealer.
+func (*Spades) Leader(game *Game) (seat int) {
+	return game.Left(game.Dealer)
+}
+
+// CanPlay returns error if player at seat leads Spades before Spades is broken.
+func (*Spades) CanPlay(game *Game, seat int, card gocard.Card) (err error) {
+	if len(game.Current.Cards) == 0 && card.Suit == gocard.SPADES && !game.Broken(gocard.SPADES) &&
+		!every(game.Hands[seat], func(c gocard.Card) bool { return c.Suit == gocard.SPADES }) {
+		return errors.New("couldn't lead Spades, Spades is not broken")
+	}
+	return err
+}
+
+// Score returns score of team of each seat.
+// Team making its contract scores 10 points per bid trick and 1 point per overtrick, otherwise it loses 10 points per bid trick.
+// Nil bid scores 100 points if the player takes no trick, otherwise it loses 100 points.
+func (rules *Spades) Score(game *Game) (scores []int) {
+	var teams [2]int
+	for team := 0; team < 2; team++ {
+		bid, tricks := 0, 0
+		for _, seat := range []int{team, team + 2} {
+			taken := game.Taken(seat)
+			tricks += taken
+			bid += rules.Bids[seat]
+			if rules.Bids[seat] == 0 {
+				if taken == 0 {
+					teams[team] += 100
+				} else {
+					teams[team] -= 100
+				}
+			}
+		}
+		if tricks >= bid {
+			teams[team] += 10*bid + tricks - bid
+		} else {
+			teams[team] -= 10 * bid
+		}
+	}
+	scores = make([]int, 4)
+	for seat := range scores {
+		scores[seat] = teams[Team(seat)]
+	}
+	return scores
+}
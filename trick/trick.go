@@ -0,0 +1,279 @@
+/*
+Package trick implements a generic engine for trick-taking games.
+
+The engine takes care of seating, dealing, follow-suit legality, winner of tricks and trick history.
+Rules of each game (e.g. Hearts, Spades, Whist, Euchre) are given as Rules.
+*/
+package trick
+
+import (
+	"errors"
+	"fmt"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Rules is a rule module of a trick-taking game.
+type Rules interface {
+	// Players returns number of players.
+	Players() int
+	// HandSize returns number of cards dealt to each player.
+	HandSize() int
+	// NewDeck returns a new deck used by the game.
+	NewDeck() gocard.Deck
+	// TrickRule returns rule to decide winner of tricks.
+	TrickRule(game *Game) gocard.TrickRule
+	// Leader returns seat of the player who leads the first trick.
+	Leader(game *Game) int
+	// CanPlay returns error if player at seat couldn't play card, in addition to follow-suit rule.
+	CanPlay(game *Game, seat int, card gocard.Card) error
+	// Score returns scores of each seat, it is called after all tricks are played.
+	Score(game *Game) []int
+}
+
+// Trick is a trick. Cards[i] is played by seat (Leader + i) % number of players.
+type Trick struct {
+	Leader int
+	Cards  gocard.Cards
+	Winner int
+}
+
+// Seat returns seat of the player who played i-th card of the trick.
+func (trick Trick) Seat(i int, players int) (seat int) {
+	return (trick.Leader + i) % players
+}
+
+// Game is a hand of a trick-taking game.
+type Game struct {
+	Rules  Rules
+	Dealer int
+	Hands  []gocard.Cards
+	// TurnUp is the top card of undealt cards (e.g. the kitty of Euchre), or the last card dealt
+	// if all cards are dealt (e.g. Whist). Some games decide trump by it.
+	TurnUp gocard.Card
+	// Tricks is history of completed tricks.
+	Tricks []Trick
+	// Current is the trick in progress.
+	Current Trick
+	// OnTrick is called when a trick is completed if it is not nil.
+	OnTrick func(trick Trick)
+	turn    int
+}
+
+// NewGame returns new game which dealer sits at seat dealer.
+func NewGame(rules Rules, dealer int) (game *Game) {
+	return &Game{
+		Rules:  rules,
+		Dealer: dealer % rules.Players(),
+		Hands:  make([]gocard.Cards, rules.Players()),
+	}
+}
+
+// Left returns seat on the left of seat.
+func (game *Game) Left(seat int) (left int) {
+	return (seat + 1) % game.Rules.Players()
+}
+
+// Deal deals cards from the deck one at a time starting left of the dealer, and returns error of short deck.
+// Undealt cards remain in the deck, and the top of them is turned up.
+func (game *Game) Deal(deck *gocard.Deck) (err error) {
+	players := game.Rules.Players()
+	if len(*deck) < players*game.Rules.HandSize() {
+		err = errors.New("couldn't deal, deck is short")
+		return err
+	}
+	hands := make([]gocard.Cards, players)
+	seat := game.Left(game.Dealer)
+	for i := 0; i < players*game.Rules.HandSize(); i++ {
+		card, _ := deck.Draw()
+		hands[seat] = append(hands[seat], card)
+		game.TurnUp = card
+		seat = game.Left(seat)
+	}
+	if len(*deck) > 0 {
+		game.TurnUp = (*deck)[0]
+	}
+	return game.Start(hands)
+}
+
+// Start starts the hand with hands already dealt, and returns error of wrong number of hands.
+func (game *Game) Start(hands []gocard.Cards) (err error) {
+	if len(hands) != game.Rules.Players() {
+		err = fmt.Errorf("couldn't start, %d hands are needed", game.Rules.Players())
+		return err
+	}
+	game.Hands = hands
+	game.Tricks = nil
+	game.turn = game.Rules.Leader(game)
+	game.Current = Trick{Leader: game.turn}
+	return err
+}
+
+// Turn returns seat of the player to play next.
+func (game *Game) Turn() (seat int) {
+	return game.turn
+}
+
+// Done returns whether all tricks are played.
+func (game *Game) Done() (done bool) {
+	return len(game.Tricks) > 0 && len(game.Current.Cards) == 0 && game.handsEmpty()
+}
+
+func (game *Game) handsEmpty() (empty bool) {
+	for _, hand := range game.Hands {
+		if len(hand) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// LedSuit returns led suit of the current trick, and false if no card is led.
+func (game *Game) LedSuit() (led gocard.Suit, ok bool) {
+	led, err := game.Rules.TrickRule(game).LedSuit(game.Current.Cards)
+	return led, err == nil
+}
+
+// Broken returns whether a card of suit has already been played in the hand.
+func (game *Game) Broken(suit gocard.Suit) (broken bool) {
+	rule := game.Rules.TrickRule(game)
+	played := game.Current.Cards
+	for _, trick := range game.Tricks {
+		played = append(played[:len(played):len(played)], trick.Cards...)
+	}
+	for _, card := range played {
+		if rule.EffectiveSuit(card) == suit {
+			return true
+		}
+	}
+	return false
+}
+
+// Won returns cards of tricks won by the player at seat.
+func (game *Game) Won(seat int) (cards gocard.Cards) {
+	for _, trick := range game.Tricks {
+		if trick.Winner == seat {
+			cards = append(cards, trick.Cards...)
+		}
+	}
+	return cards
+}
+
+// Taken returns number of tricks won by the player at seat.
+func (game *Game) Taken(seat int) (tricks int) {
+	for _, trick := range game.Tricks {
+		if trick.Winner == seat {
+			tricks++
+		}
+	}
+	return tricks
+}
+
+// Follows returns whether card follows led suit of the current trick or hand has no card of led suit.
+func (game *Game) Follows(hand gocard.Cards, card gocard.Card) (follows bool) {
+	led, ok := game.LedSuit()
+	if !ok {
+		return true
+	}
+	rule := game.Rules.TrickRule(game)
+	if rule.EffectiveSuit(card) == led {
+		return true
+	}
+	for _, other := range hand {
+		if rule.EffectiveSuit(other) == led {
+			return false
+		}
+	}
+	return true
+}
+
+// Legal returns cards which the player at seat can play now.
+func (game *Game) Legal(seat int) (cards gocard.Cards) {
+	if seat != game.turn || game.Done() {
+		return cards
+	}
+	for _, card := range game.Hands[seat] {
+		if game.Follows(game.Hands[seat], card) && game.Rules.CanPlay(game, seat, card) == nil {
+			cards = append(cards, card)
+		}
+	}
+	return cards
+}
+
+// Play plays card from hand of the player at seat, and returns error of illegal play.
+// When the trick is completed, the winner leads next trick.
+func (game *Game) Play(seat int, card gocard.Card) (err error) {
+	if game.Done() {
+		return errors.New("couldn't play, all tricks are played")
+	}
+	if seat != game.turn {
+		return fmt.Errorf("couldn't play, it is turn of seat %d", game.turn)
+	}
+	hand := game.Hands[seat]
+	index := hand.IndexOf(card)
+	if index < 0 {
+		return fmt.Errorf("couldn't play, %s is not in hand", card)
+	}
+	if !game.Follows(hand, card) {
+		return fmt.Errorf("couldn't play %s, must follow suit", card)
+	}
+	if err = game.Rules.CanPlay(game, seat, card); err != nil {
+		return err
+	}
+
+	game.Hands[seat] = append(hand[:index:index], hand[index+1:]...)
+	game.Current.Cards = append(game.Current.Cards, card)
+	game.turn = game.Left(seat)
+	if len(game.Current.Cards) < game.Rules.Players() {
+		return err
+	}
+
+	rule := game.Rules.TrickRule(game)
+	led, _ := rule.LedSuit(game.Current.Cards)
+	index, _ = rule.Winner(led, game.Current.Cards)
+	game.Current.Winner = game.Current.Seat(index, game.Rules.Players())
+	trick := game.Current
+	game.Tricks = append(game.Tricks, trick)
+	game.turn = trick.Winner
+	game.Current = Trick{Leader: trick.Winner}
+	if game.OnTrick != nil {
+		game.OnTrick(trick)
+	}
+	return err
+}
+
+// Score returns scores of each seat, and error if the hand is not finished.
+func (game *Game) Score() (scores []int, err error) {
+	if !game.Done() {
+		err = errors.New("couldn't score, hand is not finished")
+		return scores, err
+	}
+	return game.Rules.Score(game), err
+}
+
+// Team returns team of the player at seat in partnership games. (0: seats 0 and 2, 1: seats 1 and 3)
+func Team(seat int) (team int) {
+	return seat % 2
+}
+
+// every returns whether all cards in hand satisfy f.
+func every(hand gocard.Cards, f func(gocard.Card) bool) (ok bool) {
+	for _, card := range hand {
+		if !f(card) {
+			return false
+		}
+	}
+	return true
+}
+
+// newDeck returns new deck which has cards of ranks in each suit.
+func newDeck(ranks ...gocard.Rank) (deck gocard.Deck) {
+	for _, card := range gocard.NewDeck() {
+		for _, rank := range ranks {
+			if card.Rank == rank {
+				deck = append(deck, card)
+			}
+		}
+	}
+	return deck
+}
@@ -0,0 +1,171 @@
+package trick
+
+import (
+	"testing"
+
+	gocard "github.com/x-color/gocard"
+)
+
+// Setup for test
+func setupGame(rules Rules, leader int, hands ...gocard.Cards) (game *Game) {
+	game = NewGame(rules, leader+3)
+	game.Hands = hands
+	game.turn = leader
+	game.Current = Trick{Leader: leader}
+	return game
+}
+
+// For test
+func playAll(t *testing.T, game *Game) {
+	for !game.Done() {
+		legal := game.Legal(game.Turn())
+		if len(legal) == 0 {
+			t.Fatalf("No legal card for seat %d\nHand: %v", game.Turn(), game.Hands[game.Turn()])
+		}
+		if err := game.Play(game.Turn(), legal[0]); err != nil {
+			t.Fatalf("Couldn't play legal card %s\nError: %v", legal[0], err)
+		}
+	}
+}
+
+// #################################
+// Test Game.Deal()
+// #################################
+
+func TestDeal(t *testing.T) {
+	rules := Hearts{}
+	game := NewGame(rules, 0)
+	deck := rules.NewDeck()
+	deck.Shuffle()
+	if err := game.Deal(&deck); err != nil {
+		t.Fatalf("Couldn't deal\nError: %v", err)
+	}
+	for seat, hand := range game.Hands {
+		if len(hand) != 13 {
+			expected := 13
+			actual := len(hand)
+			msg := "Expected 13 cards are dealt to each seat, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+		if hand.Contains(twoOfClubs) && game.Turn() != seat {
+			expected := seat
+			actual := game.Turn()
+			msg := "Expected the holder of Two of Clubs leads, but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+	}
+}
+
+func TestDealShortDeck(t *testing.T) {
+	game := NewGame(Hearts{}, 0)
+	deck := gocard.NewDeck()[:40]
+	if err := game.Deal(&deck); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as dealing from short deck"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Game.Play()
+// #################################
+
+func TestPlayMustFollowSuit(t *testing.T) {
+	game := setupGame(Whist{}, 0,
+		gocard.Cards{{Rank: gocard.ACE, Suit: gocard.SPADES}},
+		gocard.Cards{{Rank: gocard.TWO, Suit: gocard.HEARTS}, {Rank: gocard.TWO, Suit: gocard.SPADES}},
+		gocard.Cards{{Rank: gocard.THREE, Suit: gocard.HEARTS}},
+		gocard.Cards{{Rank: gocard.FOUR, Suit: gocard.HEARTS}},
+	)
+	game.Play(0, gocard.Card{Rank: gocard.ACE, Suit: gocard.SPADES})
+
+	if err := game.Play(1, gocard.Card{Rank: gocard.TWO, Suit: gocard.HEARTS}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as not following suit"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := game.Play(2, gocard.Card{Rank: gocard.THREE, Suit: gocard.HEARTS}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as playing out of turn"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if legal := game.Legal(1); len(legal) != 1 || legal[0].Suit != gocard.SPADES {
+		expected := gocard.Cards{{Rank: gocard.TWO, Suit: gocard.SPADES}}
+		actual := legal
+		msg := "Expected only card of led suit is legal, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestPlayWinnerLeadsNext(t *testing.T) {
+	game := setupGame(Whist{}, 1,
+		gocard.Cards{{Rank: gocard.KING, Suit: gocard.CLUBS}, {Rank: gocard.TWO, Suit: gocard.CLUBS}},
+		gocard.Cards{{Rank: gocard.TEN, Suit: gocard.CLUBS}, {Rank: gocard.THREE, Suit: gocard.CLUBS}},
+		gocard.Cards{{Rank: gocard.ACE, Suit: gocard.CLUBS}, {Rank: gocard.FOUR, Suit: gocard.CLUBS}},
+		gocard.Cards{{Rank: gocard.TWO, Suit: gocard.HEARTS}, {Rank: gocard.FIVE, Suit: gocard.DIAMONDS}},
+	)
+	game.TurnUp = gocard.Card{Rank: gocard.TWO, Suit: gocard.HEARTS}
+	var completed []Trick
+	game.OnTrick = func(trick Trick) {
+		completed = append(completed, trick)
+	}
+	for _, seat := range []int{1, 2, 3, 0} {
+		if err := game.Play(seat, game.Legal(seat)[0]); err != nil {
+			t.Fatalf("Couldn't play legal card\nError: %v", err)
+		}
+	}
+
+	if len(completed) != 1 || completed[0].Winner != 3 || game.Turn() != 3 {
+		expected := 3
+		actual := game.Turn()
+		msg := "Expected the trump wins and its player leads next trick, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test Game.Score()
+// #################################
+
+func TestScoreNotFinished(t *testing.T) {
+	game := NewGame(Whist{}, 0)
+	deck := gocard.NewDeck()
+	game.Deal(&deck)
+	if _, err := game.Score(); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as scoring unfinished hand"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestScoreFullHand(t *testing.T) {
+	testCases := map[string]Rules{
+		"Hearts": Hearts{},
+		"Spades": &Spades{Bids: [4]int{3, 3, 3, 3}},
+		"Whist":  Whist{},
+		"Euchre": &Euchre{Trump: gocard.HEARTS},
+	}
+	for name, rules := range testCases {
+		game := NewGame(rules, 0)
+		deck := rules.NewDeck()
+		deck.Shuffle()
+		if err := game.Deal(&deck); err != nil {
+			t.Fatalf("Couldn't deal %s\nError: %v", name, err)
+		}
+		playAll(t, game)
+
+		if len(game.Tricks) != rules.HandSize() {
+			expected := rules.HandSize()
+			actual := len(game.Tricks)
+			msg := "Expected all tricks are played in " + name + ", but not"
+			t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+		}
+		if _, err := game.Score(); err != nil {
+			t.Fatalf("Couldn't score %s\nError: %v", name, err)
+		}
+	}
+}
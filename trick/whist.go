@@ -0,0 +1,54 @@
+package trick
+
+import (
+	gocard "github.com/x-color/gocard"
+)
+
+// Whist is rules of partnership Whist for 4 players.
+// Suit of the last card dealt (turned up) is trump.
+type Whist struct{}
+
+// Players returns number of players of Whist.
+func (Whist) Players() (players int) {
+	return 4
+}
+
+// HandSize returns number of cards dealt to each player.
+func (Whist) HandSize() (size int) {
+	return 13
+}
+
+// NewDeck returns a deck of 52 cards.
+func (Whist) NewDeck() (deck gocard.Deck) {
+	return gocard.NewDeck()
+}
+
+// TrickRule returns rule which suit of turned up card is trump.
+func (Whist) TrickRule(game *Game) (rule gocard.TrickRule) {
+	return gocard.TrickRule{Trump: game.TurnUp.Suit}
+}
+
+// Leader returns seat on the left of the dealer.
+func (Whist) Leader(game *Game) (seat int) {
+	return game.Left(game.Dealer)
+}
+
+// CanPlay returns nil, Whist has no rule except following suit.
+func (Whist) CanPlay(game *Game, seat int, card gocard.Card) (err error) {
+	return err
+}
+
+// Score returns score of team of each seat. Team scores 1 point per trick over six.
+func (Whist) Score(game *Game) (scores []int) {
+	var tricks [2]int
+	for seat := 0; seat < 4; seat++ {
+		tricks[Team(seat)] += game.Taken(seat)
+	}
+	scores = make([]int, 4)
+	for seat := range scores {
+		if tricks[Team(seat)] > 6 {
+			scores[seat] = tricks[Team(seat)] - 6
+		}
+	}
+	return scores
+}
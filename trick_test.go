@@ -0,0 +1,118 @@
+package card
+
+import (
+	"testing"
+)
+
+// Setup for test
+func setupDefaultRanking() {
+	SetRankingOfRanks([]Rank{TWO, THREE, FOUR, FIVE, SIX, SEVEN, EIGHT, NINE, TEN, JACK, QUEEN, KING, ACE})
+	SetRankingOfSuits([]Suit{CLUBS, DIAMONDS, HEARTS, SPADES})
+}
+
+// #################################
+// Test TrickRule.Winner()
+// #################################
+
+func TestWinnerNoTrump(t *testing.T) {
+	setupDefaultRanking()
+	rule := TrickRule{Trump: NOTRUMP}
+	cards := Cards{
+		{Rank: TEN, Suit: HEARTS},
+		{Rank: ACE, Suit: SPADES},
+		{Rank: KING, Suit: HEARTS},
+		{Rank: TWO, Suit: HEARTS},
+	}
+
+	if index, err := rule.Winner(HEARTS, cards); err != nil || index != 2 {
+		expected := 2
+		actual := index
+		msg := "Expected the highest card of led suit wins, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestWinnerTrump(t *testing.T) {
+	setupDefaultRanking()
+	rule := TrickRule{Trump: CLUBS}
+	cards := Cards{
+		{Rank: ACE, Suit: HEARTS},
+		{Rank: TWO, Suit: CLUBS},
+		{Rank: KING, Suit: HEARTS},
+		{Rank: THREE, Suit: CLUBS},
+	}
+
+	if index, err := rule.Winner(HEARTS, cards); err != nil || index != 3 {
+		expected := 3
+		actual := index
+		msg := "Expected the highest trump wins, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestWinnerBowers(t *testing.T) {
+	setupDefaultRanking()
+	rule := TrickRule{Trump: HEARTS, Bowers: true}
+	cards := Cards{
+		{Rank: ACE, Suit: HEARTS},
+		{Rank: JACK, Suit: DIAMONDS},
+		{Rank: KING, Suit: HEARTS},
+		{Rank: JACK, Suit: HEARTS},
+	}
+
+	if index, err := rule.Winner(HEARTS, cards); err != nil || index != 3 {
+		expected := 3
+		actual := index
+		msg := "Expected the right bower wins, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+	if index, err := rule.Winner(HEARTS, cards[:3]); err != nil || index != 1 {
+		expected := 1
+		actual := index
+		msg := "Expected the left bower wins, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestWinnerSameCards(t *testing.T) {
+	setupDefaultRanking()
+	rule := TrickRule{Trump: SPADES}
+	cards := Cards{
+		{Rank: NINE, Suit: DIAMONDS},
+		{Rank: ACE, Suit: DIAMONDS},
+		{Rank: ACE, Suit: DIAMONDS},
+	}
+
+	if index, err := rule.Winner(DIAMONDS, cards); err != nil || index != 1 {
+		expected := 1
+		actual := index
+		msg := "Expected the card played first wins, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
+
+func TestWinnerEmptyTrick(t *testing.T) {
+	rule := TrickRule{}
+	if _, err := rule.Winner(SPADES, Cards{}); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as deciding winner of empty trick"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test TrickRule.LedSuit()
+// #################################
+
+func TestLedSuitLeftBower(t *testing.T) {
+	rule := TrickRule{Trump: SPADES, Bowers: true}
+	cards := Cards{{Rank: JACK, Suit: CLUBS}}
+
+	if led, err := rule.LedSuit(cards); err != nil || led != SPADES {
+		expected := SPADES
+		actual := led
+		msg := "Expected the left bower leads trump, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v (%v)", msg, expected, actual, err)
+	}
+}
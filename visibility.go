@@ -0,0 +1,164 @@
+package card
+
+import (
+	"fmt"
+)
+
+// Back is a card which face is hidden. Views of cards have Back for cards which are not visible.
+// It is not a real card, and it is different from zero value of Card which may be used as a joker.
+var Back = Card{Rank: -1, Suit: -1}
+
+// Visibility is visibility of a card. (FACEDOWN, FACEUP, KNOWN)
+type Visibility int
+
+// These constant values are visibilities of card.
+// FACEDOWN is visible to no one, FACEUP is visible to everyone,
+// and KNOWN is face down but visible to some players.
+const (
+	FACEDOWN Visibility = iota + 1
+	FACEUP
+	KNOWN
+)
+
+// String returns string of visibility. (e.g. Face up)
+func (visibility Visibility) String() (msg string) {
+	switch visibility {
+	case FACEDOWN:
+		return "Face down"
+	case FACEUP:
+		return "Face up"
+	case KNOWN:
+		return "Known"
+	default:
+		return "Unknown"
+	}
+}
+
+// VisibleCard is a card with visibility. Known is seats of players who know the card.
+type VisibleCard struct {
+	Card       Card
+	Visibility Visibility
+	Known      []int
+}
+
+// VisibleTo returns whether the player of seat can see the card.
+func (card VisibleCard) VisibleTo(seat int) (visible bool) {
+	switch card.Visibility {
+	case FACEUP:
+		return true
+	case KNOWN:
+		for _, s := range card.Known {
+			if s == seat {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// VisibleCards is a slice of VisibleCard.
+type VisibleCards []VisibleCard
+
+// FaceUp returns cards which are face up.
+func FaceUp(cards Cards) (visible VisibleCards) {
+	for _, card := range cards {
+		visible = append(visible, VisibleCard{Card: card, Visibility: FACEUP})
+	}
+	return visible
+}
+
+// FaceDown returns cards which are face down.
+func FaceDown(cards Cards) (visible VisibleCards) {
+	for _, card := range cards {
+		visible = append(visible, VisibleCard{Card: card, Visibility: FACEDOWN})
+	}
+	return visible
+}
+
+// Hand returns cards which are face down and known to the player of seat.
+func Hand(cards Cards, seat int) (visible VisibleCards) {
+	for _, card := range cards {
+		visible = append(visible, VisibleCard{Card: card, Visibility: KNOWN, Known: []int{seat}})
+	}
+	return visible
+}
+
+// Cards returns all cards regardless of visibility.
+func (visible VisibleCards) Cards() (cards Cards) {
+	for _, card := range visible {
+		cards = append(cards, card.Card)
+	}
+	return cards
+}
+
+// View returns cards seen by the player of seat. Cards which are not visible are Back.
+// Use seat -1 for spectators who see only face-up cards.
+func (visible VisibleCards) View(seat int) (cards Cards) {
+	cards = make(Cards, len(visible))
+	for i, card := range visible {
+		cards[i] = Back
+		if card.VisibleTo(seat) {
+			cards[i] = card.Card
+		}
+	}
+	return cards
+}
+
+// check returns error if index is out of cards.
+func (visible VisibleCards) check(index int) (err error) {
+	if index < 0 || index >= len(visible) {
+		err = fmt.Errorf("couldn't change visibility, index %d is out of cards", index)
+	}
+	return err
+}
+
+// TurnUp turns up card at index, and returns error if index is out of cards.
+func (visible VisibleCards) TurnUp(index int) (err error) {
+	if err = visible.check(index); err != nil {
+		return err
+	}
+	visible[index].Visibility, visible[index].Known = FACEUP, nil
+	return err
+}
+
+// TurnDown turns down card at index, and returns error if index is out of cards.
+// Players who knew the card still know it.
+func (visible VisibleCards) TurnDown(index int) (err error) {
+	if err = visible.check(index); err != nil {
+		return err
+	}
+	if visible[index].Visibility == FACEUP {
+		visible[index].Visibility = FACEDOWN
+	}
+	if len(visible[index].Known) > 0 {
+		visible[index].Visibility = KNOWN
+	}
+	return err
+}
+
+// Show shows face-down card at index to the player of seat, and returns error if index is out of cards.
+func (visible VisibleCards) Show(index int, seat int) (err error) {
+	if err = visible.check(index); err != nil {
+		return err
+	}
+	card := &visible[index]
+	if card.Visibility == FACEUP || card.VisibleTo(seat) {
+		return err
+	}
+	card.Visibility = KNOWN
+	card.Known = append(append([]int{}, card.Known...), seat)
+	return err
+}
+
+// VisibleTable is named piles of cards with visibility. (e.g. "hand0", "board")
+type VisibleTable map[string]VisibleCards
+
+// View returns redacted snapshot of piles seen by the player of seat.
+// It has Back for cards which are not visible, so it is safe to send to the player.
+func (table VisibleTable) View(seat int) (view map[string]Cards) {
+	view = map[string]Cards{}
+	for name, pile := range table {
+		view[name] = pile.View(seat)
+	}
+	return view
+}
@@ -0,0 +1,103 @@
+package card
+
+import (
+	"testing"
+)
+
+// #################################
+// Test VisibleCards.View()
+// #################################
+
+func TestViewOfHand(t *testing.T) {
+	cards := Cards{{Rank: ACE, Suit: SPADES}, {Rank: KING, Suit: HEARTS}}
+	hand := Hand(cards, 0)
+
+	if view := hand.View(0); view[0] != cards[0] || view[1] != cards[1] {
+		expected := cards
+		actual := view
+		msg := "Expected the owner sees own hand, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if view := hand.View(1); len(view) != 2 || view[0] != Back || view[1] != Back {
+		expected := Cards{Back, Back}
+		actual := view
+		msg := "Expected other players see backs of hand, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestViewAfterTurnUpAndShow(t *testing.T) {
+	cards := FaceDown(Cards{{Rank: ACE, Suit: SPADES}, {Rank: KING, Suit: HEARTS}, {Rank: TWO, Suit: CLUBS}})
+	cards.TurnUp(0)
+	cards.Show(1, 2)
+
+	if view := cards.View(-1); view[0] != cards[0].Card || view[1] != Back || view[2] != Back {
+		expected := Cards{cards[0].Card, Back, Back}
+		actual := view
+		msg := "Expected spectators see only face-up cards, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if view := cards.View(2); view[1] != cards[1].Card || view[2] != Back {
+		expected := Cards{cards[0].Card, cards[1].Card, Back}
+		actual := view
+		msg := "Expected the player sees shown card, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test VisibleCards.TurnDown()
+// #################################
+
+func TestTurnDown(t *testing.T) {
+	cards := Hand(Cards{{Rank: ACE, Suit: SPADES}}, 1)
+	cards.TurnUp(0)
+	cards.Show(0, 3)
+	cards.TurnDown(0)
+
+	if cards[0].VisibleTo(3) || cards[0].Visibility != FACEDOWN {
+		expected := FACEDOWN
+		actual := cards[0].Visibility
+		msg := "Expected card turned down after turned up is visible to no one, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+	if err := cards.TurnDown(1); err == nil {
+		expected := "error"
+		actual := err
+		msg := "Couldn't catch error as turning down card out of cards"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+// #################################
+// Test VisibleTable.View()
+// #################################
+
+func TestViewOfTable(t *testing.T) {
+	table := VisibleTable{
+		"hand0": Hand(Cards{{Rank: ACE, Suit: SPADES}}, 0),
+		"hand1": Hand(Cards{{Rank: KING, Suit: SPADES}}, 1),
+		"board": FaceUp(Cards{{Rank: TWO, Suit: CLUBS}}),
+	}
+
+	view := table.View(1)
+	if view["hand0"][0] != Back || view["hand1"][0].Rank != KING || view["board"][0].Rank != TWO {
+		expected := "Back, King of Spades, Two of Clubs"
+		actual := view
+		msg := "View of table is not redacted for the player"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}
+
+func TestViewOfZeroCard(t *testing.T) {
+	// Zero value of Card is a joker of some games, so it must not be confused with Back.
+	cards := FaceDown(Cards{{}, {}})
+	cards.TurnUp(0)
+
+	if view := cards.View(-1); view[0] != (Card{}) || view[1] != Back {
+		expected := Cards{{}, Back}
+		actual := view
+		msg := "Expected face-up zero card differs from hidden card, but not"
+		t.Fatalf("%s\nExpected: %v\nActual  : %v", msg, expected, actual)
+	}
+}